@@ -15,12 +15,18 @@ import (
 )
 
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                      protoreflect.MessageDescriptor
+	fd_Params_catch_up_policy      protoreflect.FieldDescriptor
+	fd_Params_max_epochs_per_block protoreflect.FieldDescriptor
+	fd_Params_history_retention    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_galactica_epochs_params_proto_init()
 	md_Params = File_galactica_epochs_params_proto.Messages().ByName("Params")
+	fd_Params_catch_up_policy = md_Params.Fields().ByName("catch_up_policy")
+	fd_Params_max_epochs_per_block = md_Params.Fields().ByName("max_epochs_per_block")
+	fd_Params_history_retention = md_Params.Fields().ByName("history_retention")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +94,24 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.CatchUpPolicy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.CatchUpPolicy))
+		if !f(fd_Params_catch_up_policy, value) {
+			return
+		}
+	}
+	if x.MaxEpochsPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxEpochsPerBlock)
+		if !f(fd_Params_max_epochs_per_block, value) {
+			return
+		}
+	}
+	if x.HistoryRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HistoryRetention)
+		if !f(fd_Params_history_retention, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +127,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "galactica.epochs.Params.catch_up_policy":
+		return x.CatchUpPolicy != 0
+	case "galactica.epochs.Params.max_epochs_per_block":
+		return x.MaxEpochsPerBlock != uint64(0)
+	case "galactica.epochs.Params.history_retention":
+		return x.HistoryRetention != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: galactica.epochs.Params"))
@@ -119,6 +149,12 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "galactica.epochs.Params.catch_up_policy":
+		x.CatchUpPolicy = 0
+	case "galactica.epochs.Params.max_epochs_per_block":
+		x.MaxEpochsPerBlock = uint64(0)
+	case "galactica.epochs.Params.history_retention":
+		x.HistoryRetention = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: galactica.epochs.Params"))
@@ -135,6 +171,15 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "galactica.epochs.Params.catch_up_policy":
+		value := x.CatchUpPolicy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "galactica.epochs.Params.max_epochs_per_block":
+		value := x.MaxEpochsPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "galactica.epochs.Params.history_retention":
+		value := x.HistoryRetention
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: galactica.epochs.Params"))
@@ -155,6 +200,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "galactica.epochs.Params.catch_up_policy":
+		x.CatchUpPolicy = (CatchUpPolicy)(value.Enum())
+	case "galactica.epochs.Params.max_epochs_per_block":
+		x.MaxEpochsPerBlock = value.Uint()
+	case "galactica.epochs.Params.history_retention":
+		x.HistoryRetention = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: galactica.epochs.Params"))
@@ -175,6 +226,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "galactica.epochs.Params.catch_up_policy":
+		panic(fmt.Errorf("field catch_up_policy of message galactica.epochs.Params is not mutable"))
+	case "galactica.epochs.Params.max_epochs_per_block":
+		panic(fmt.Errorf("field max_epochs_per_block of message galactica.epochs.Params is not mutable"))
+	case "galactica.epochs.Params.history_retention":
+		panic(fmt.Errorf("field history_retention of message galactica.epochs.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: galactica.epochs.Params"))
@@ -188,6 +245,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "galactica.epochs.Params.catch_up_policy":
+		return protoreflect.ValueOfEnum(0)
+	case "galactica.epochs.Params.max_epochs_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "galactica.epochs.Params.history_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: galactica.epochs.Params"))
@@ -257,6 +320,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.CatchUpPolicy != 0 {
+			n += 1 + runtime.Sov(uint64(x.CatchUpPolicy))
+		}
+		if x.MaxEpochsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxEpochsPerBlock))
+		}
+		if x.HistoryRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistoryRetention))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +358,21 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.HistoryRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoryRetention))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxEpochsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxEpochsPerBlock))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.CatchUpPolicy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CatchUpPolicy))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +422,63 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+				}
+				x.CatchUpPolicy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CatchUpPolicy |= CatchUpPolicy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEpochsPerBlock", wireType)
+				}
+				x.MaxEpochsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxEpochsPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+				}
+				x.HistoryRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoryRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -383,11 +527,79 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CatchUpPolicy defines how the module processes epochs that were missed,
+// e.g. after a chain halt, when more than one epoch duration has elapsed since
+// the start of the current epoch.
+type CatchUpPolicy int32
+
+const (
+	// CATCH_UP_POLICY_CAPPED processes at most max_epochs_per_block missed
+	// epochs per block. With max_epochs_per_block of 0 or 1 this is the
+	// original behaviour of advancing one epoch per block.
+	CatchUpPolicy_CATCH_UP_POLICY_CAPPED CatchUpPolicy = 0
+	// CATCH_UP_POLICY_ALL processes all missed epochs in a single block.
+	CatchUpPolicy_CATCH_UP_POLICY_ALL CatchUpPolicy = 1
+	// CATCH_UP_POLICY_SKIP ends the current epoch, skips the missed epochs
+	// without calling hooks for them and starts the epoch matching the block
+	// time.
+	CatchUpPolicy_CATCH_UP_POLICY_SKIP CatchUpPolicy = 2
+)
+
+// Enum value maps for CatchUpPolicy.
+var (
+	CatchUpPolicy_name = map[int32]string{
+		0: "CATCH_UP_POLICY_CAPPED",
+		1: "CATCH_UP_POLICY_ALL",
+		2: "CATCH_UP_POLICY_SKIP",
+	}
+	CatchUpPolicy_value = map[string]int32{
+		"CATCH_UP_POLICY_CAPPED": 0,
+		"CATCH_UP_POLICY_ALL":    1,
+		"CATCH_UP_POLICY_SKIP":   2,
+	}
+)
+
+func (x CatchUpPolicy) Enum() *CatchUpPolicy {
+	p := new(CatchUpPolicy)
+	*p = x
+	return p
+}
+
+func (x CatchUpPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatchUpPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_galactica_epochs_params_proto_enumTypes[0].Descriptor()
+}
+
+func (CatchUpPolicy) Type() protoreflect.EnumType {
+	return &file_galactica_epochs_params_proto_enumTypes[0]
+}
+
+func (x CatchUpPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatchUpPolicy.Descriptor instead.
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_galactica_epochs_params_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// catch_up_policy defines how missed epochs are processed
+	CatchUpPolicy CatchUpPolicy `protobuf:"varint,1,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=galactica.epochs.CatchUpPolicy" json:"catch_up_policy,omitempty"`
+	// max_epochs_per_block is the number of missed epochs processed per block
+	// under CATCH_UP_POLICY_CAPPED. 0 is treated as 1.
+	MaxEpochsPerBlock uint64 `protobuf:"varint,2,opt,name=max_epochs_per_block,json=maxEpochsPerBlock,proto3" json:"max_epochs_per_block,omitempty"`
+	// history_retention is the number of completed epochs per identifier kept
+	// in the epoch history. 0 keeps all records.
+	HistoryRetention uint64 `protobuf:"varint,3,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +622,27 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_galactica_epochs_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetCatchUpPolicy() CatchUpPolicy {
+	if x != nil {
+		return x.CatchUpPolicy
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_CAPPED
+}
+
+func (x *Params) GetMaxEpochsPerBlock() uint64 {
+	if x != nil {
+		return x.MaxEpochsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetHistoryRetention() uint64 {
+	if x != nil {
+		return x.HistoryRetention
+	}
+	return 0
+}
+
 var File_galactica_epochs_params_proto protoreflect.FileDescriptor
 
 var file_galactica_epochs_params_proto_rawDesc = []byte{
@@ -418,21 +651,42 @@ var file_galactica_epochs_params_proto_rawDesc = []byte{
 	0x10, 0x67, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x2e, 0x65, 0x70, 0x6f, 0x63, 0x68,
 	0x73, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2c, 0x0a, 0x06, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x3a, 0x22, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x67,
-	0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x2f, 0x78, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x73, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xa7, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x01, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0f, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75,
+	0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
 	0x2e, 0x67, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x2e, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x73, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x67, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x2f, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x73, 0xa2, 0x02, 0x03, 0x47, 0x45, 0x58, 0xaa, 0x02, 0x10, 0x47, 0x61, 0x6c, 0x61,
-	0x63, 0x74, 0x69, 0x63, 0x61, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0xca, 0x02, 0x10, 0x47,
-	0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x5c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0xe2,
-	0x02, 0x1c, 0x47, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x5c, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x11, 0x47, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x3a, 0x3a, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0d, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x2b, 0x0a, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x22, 0xe8, 0xa0,
+	0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x67, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61,
+	0x2f, 0x78, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x2a, 0xaa, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x33, 0x0a, 0x16, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x50, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x43, 0x41, 0x50, 0x50, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x17,
+	0x8a, 0x9d, 0x20, 0x13, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x43, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x43, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x55, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01,
+	0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x41, 0x6c, 0x6c, 0x12, 0x2f, 0x0a, 0x14, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x55, 0x50, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x02,
+	0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x6b, 0x69, 0x70, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xa7, 0x01,
+	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x2e,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x21, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63,
+	0x61, 0x2f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0xa2, 0x02, 0x03, 0x47, 0x45, 0x58, 0xaa, 0x02,
+	0x10, 0x47, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x73, 0xca, 0x02, 0x10, 0x47, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x5c, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x73, 0xe2, 0x02, 0x1c, 0x47, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61,
+	0x5c, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x47, 0x61, 0x6c, 0x61, 0x63, 0x74, 0x69, 0x63, 0x61, 0x3a,
+	0x3a, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -447,16 +701,19 @@ func file_galactica_epochs_params_proto_rawDescGZIP() []byte {
 	return file_galactica_epochs_params_proto_rawDescData
 }
 
+var file_galactica_epochs_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_galactica_epochs_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_galactica_epochs_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: galactica.epochs.Params
+	(CatchUpPolicy)(0), // 0: galactica.epochs.CatchUpPolicy
+	(*Params)(nil),     // 1: galactica.epochs.Params
 }
 var file_galactica_epochs_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: galactica.epochs.Params.catch_up_policy:type_name -> galactica.epochs.CatchUpPolicy
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_galactica_epochs_params_proto_init() }
@@ -483,13 +740,14 @@ func file_galactica_epochs_params_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_galactica_epochs_params_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_galactica_epochs_params_proto_goTypes,
 		DependencyIndexes: file_galactica_epochs_params_proto_depIdxs,
+		EnumInfos:         file_galactica_epochs_params_proto_enumTypes,
 		MessageInfos:      file_galactica_epochs_params_proto_msgTypes,
 	}.Build()
 	File_galactica_epochs_params_proto = out.File
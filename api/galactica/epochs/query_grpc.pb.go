@@ -20,6 +20,27 @@ const _ = grpc.SupportPackageIsVersion7
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EpochInfos provide running epochInfos
+	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
+	// CurrentEpoch provide current epoch of specified identifier
+	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochInfo provides the epoch info of the specified identifier
+	EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error)
+	// NextEpoch provides the projected end of the current epoch of the
+	// specified identifier
+	NextEpoch(ctx context.Context, in *QueryNextEpochRequest, opts ...grpc.CallOption) (*QueryNextEpochResponse, error)
+	// EpochHistory provides the records of the completed epochs of the
+	// specified identifier
+	EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error)
+	// EpochStats provides the chain activity statistics of the current or a
+	// completed epoch of the specified identifier
+	EpochStats(ctx context.Context, in *QueryEpochStatsRequest, opts ...grpc.CallOption) (*QueryEpochStatsResponse, error)
+	// ScheduledExecutions provides the pending scheduled executions, optionally
+	// filtered by epoch identifier
+	ScheduledExecutions(ctx context.Context, in *QueryScheduledExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsResponse, error)
+	// ScheduledExecution provides the pending scheduled execution of the
+	// specified id
+	ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error)
 }
 
 type queryClient struct {
@@ -39,12 +60,105 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error) {
+	out := new(QueryEpochsInfoResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/EpochInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
+	out := new(QueryCurrentEpochResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/CurrentEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochInfo(ctx context.Context, in *QueryEpochInfoRequest, opts ...grpc.CallOption) (*QueryEpochInfoResponse, error) {
+	out := new(QueryEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/EpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextEpoch(ctx context.Context, in *QueryNextEpochRequest, opts ...grpc.CallOption) (*QueryNextEpochResponse, error) {
+	out := new(QueryNextEpochResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/NextEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochHistory(ctx context.Context, in *QueryEpochHistoryRequest, opts ...grpc.CallOption) (*QueryEpochHistoryResponse, error) {
+	out := new(QueryEpochHistoryResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/EpochHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochStats(ctx context.Context, in *QueryEpochStatsRequest, opts ...grpc.CallOption) (*QueryEpochStatsResponse, error) {
+	out := new(QueryEpochStatsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/EpochStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecutions(ctx context.Context, in *QueryScheduledExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionsResponse, error) {
+	out := new(QueryScheduledExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/ScheduledExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledExecution(ctx context.Context, in *QueryScheduledExecutionRequest, opts ...grpc.CallOption) (*QueryScheduledExecutionResponse, error) {
+	out := new(QueryScheduledExecutionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Query/ScheduledExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EpochInfos provide running epochInfos
+	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
+	// CurrentEpoch provide current epoch of specified identifier
+	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochInfo provides the epoch info of the specified identifier
+	EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error)
+	// NextEpoch provides the projected end of the current epoch of the
+	// specified identifier
+	NextEpoch(context.Context, *QueryNextEpochRequest) (*QueryNextEpochResponse, error)
+	// EpochHistory provides the records of the completed epochs of the
+	// specified identifier
+	EpochHistory(context.Context, *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error)
+	// EpochStats provides the chain activity statistics of the current or a
+	// completed epoch of the specified identifier
+	EpochStats(context.Context, *QueryEpochStatsRequest) (*QueryEpochStatsResponse, error)
+	// ScheduledExecutions provides the pending scheduled executions, optionally
+	// filtered by epoch identifier
+	ScheduledExecutions(context.Context, *QueryScheduledExecutionsRequest) (*QueryScheduledExecutionsResponse, error)
+	// ScheduledExecution provides the pending scheduled execution of the
+	// specified id
+	ScheduledExecution(context.Context, *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -55,6 +169,30 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfos not implemented")
+}
+func (UnimplementedQueryServer) CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+}
+func (UnimplementedQueryServer) EpochInfo(context.Context, *QueryEpochInfoRequest) (*QueryEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochInfo not implemented")
+}
+func (UnimplementedQueryServer) NextEpoch(context.Context, *QueryNextEpochRequest) (*QueryNextEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextEpoch not implemented")
+}
+func (UnimplementedQueryServer) EpochHistory(context.Context, *QueryEpochHistoryRequest) (*QueryEpochHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochHistory not implemented")
+}
+func (UnimplementedQueryServer) EpochStats(context.Context, *QueryEpochStatsRequest) (*QueryEpochStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochStats not implemented")
+}
+func (UnimplementedQueryServer) ScheduledExecutions(context.Context, *QueryScheduledExecutionsRequest) (*QueryScheduledExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecutions not implemented")
+}
+func (UnimplementedQueryServer) ScheduledExecution(context.Context, *QueryScheduledExecutionRequest) (*QueryScheduledExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledExecution not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +224,150 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EpochInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/EpochInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfos(ctx, req.(*QueryEpochsInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/CurrentEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/EpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochInfo(ctx, req.(*QueryEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/NextEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextEpoch(ctx, req.(*QueryNextEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/EpochHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochHistory(ctx, req.(*QueryEpochHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/EpochStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochStats(ctx, req.(*QueryEpochStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/ScheduledExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecutions(ctx, req.(*QueryScheduledExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Query/ScheduledExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledExecution(ctx, req.(*QueryScheduledExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +379,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "EpochInfos",
+			Handler:    _Query_EpochInfos_Handler,
+		},
+		{
+			MethodName: "CurrentEpoch",
+			Handler:    _Query_CurrentEpoch_Handler,
+		},
+		{
+			MethodName: "EpochInfo",
+			Handler:    _Query_EpochInfo_Handler,
+		},
+		{
+			MethodName: "NextEpoch",
+			Handler:    _Query_NextEpoch_Handler,
+		},
+		{
+			MethodName: "EpochHistory",
+			Handler:    _Query_EpochHistory_Handler,
+		},
+		{
+			MethodName: "EpochStats",
+			Handler:    _Query_EpochStats_Handler,
+		},
+		{
+			MethodName: "ScheduledExecutions",
+			Handler:    _Query_ScheduledExecutions_Handler,
+		},
+		{
+			MethodName: "ScheduledExecution",
+			Handler:    _Query_ScheduledExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "galactica/epochs/query.proto",
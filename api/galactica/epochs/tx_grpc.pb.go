@@ -23,6 +23,29 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateEpochInfo defines a (governance) operation for registering a new
+	// epoch identifier. The authority defaults to the x/gov module account.
+	CreateEpochInfo(ctx context.Context, in *MsgCreateEpochInfo, opts ...grpc.CallOption) (*MsgCreateEpochInfoResponse, error)
+	// UpdateEpochDuration defines a (governance) operation for changing the
+	// duration of an existing epoch identifier. The new duration applies from
+	// the currently running epoch onwards.
+	UpdateEpochDuration(ctx context.Context, in *MsgUpdateEpochDuration, opts ...grpc.CallOption) (*MsgUpdateEpochDurationResponse, error)
+	// DeleteEpochInfo defines a (governance) operation for removing an epoch
+	// identifier. Hooks are no longer called for a deleted identifier.
+	// Identifiers that registered hooks depend on cannot be deleted.
+	DeleteEpochInfo(ctx context.Context, in *MsgDeleteEpochInfo, opts ...grpc.CallOption) (*MsgDeleteEpochInfoResponse, error)
+	// PauseEpoch defines a (governance) operation for pausing an epoch
+	// identifier. Paused epochs are neither started nor ended.
+	PauseEpoch(ctx context.Context, in *MsgPauseEpoch, opts ...grpc.CallOption) (*MsgPauseEpochResponse, error)
+	// ResumeEpoch defines a (governance) operation for resuming a paused epoch
+	// identifier.
+	ResumeEpoch(ctx context.Context, in *MsgResumeEpoch, opts ...grpc.CallOption) (*MsgResumeEpochResponse, error)
+	// ScheduleExecution defines a (governance) operation for executing
+	// authority-signed messages at the start of an epoch.
+	ScheduleExecution(ctx context.Context, in *MsgScheduleExecution, opts ...grpc.CallOption) (*MsgScheduleExecutionResponse, error)
+	// CancelExecution defines a (governance) operation for cancelling a pending
+	// scheduled execution.
+	CancelExecution(ctx context.Context, in *MsgCancelExecution, opts ...grpc.CallOption) (*MsgCancelExecutionResponse, error)
 }
 
 type msgClient struct {
@@ -42,6 +65,69 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CreateEpochInfo(ctx context.Context, in *MsgCreateEpochInfo, opts ...grpc.CallOption) (*MsgCreateEpochInfoResponse, error) {
+	out := new(MsgCreateEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Msg/CreateEpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEpochDuration(ctx context.Context, in *MsgUpdateEpochDuration, opts ...grpc.CallOption) (*MsgUpdateEpochDurationResponse, error) {
+	out := new(MsgUpdateEpochDurationResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Msg/UpdateEpochDuration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteEpochInfo(ctx context.Context, in *MsgDeleteEpochInfo, opts ...grpc.CallOption) (*MsgDeleteEpochInfoResponse, error) {
+	out := new(MsgDeleteEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Msg/DeleteEpochInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseEpoch(ctx context.Context, in *MsgPauseEpoch, opts ...grpc.CallOption) (*MsgPauseEpochResponse, error) {
+	out := new(MsgPauseEpochResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Msg/PauseEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeEpoch(ctx context.Context, in *MsgResumeEpoch, opts ...grpc.CallOption) (*MsgResumeEpochResponse, error) {
+	out := new(MsgResumeEpochResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Msg/ResumeEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleExecution(ctx context.Context, in *MsgScheduleExecution, opts ...grpc.CallOption) (*MsgScheduleExecutionResponse, error) {
+	out := new(MsgScheduleExecutionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Msg/ScheduleExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelExecution(ctx context.Context, in *MsgCancelExecution, opts ...grpc.CallOption) (*MsgCancelExecutionResponse, error) {
+	out := new(MsgCancelExecutionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.epochs.Msg/CancelExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -51,6 +137,29 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateEpochInfo defines a (governance) operation for registering a new
+	// epoch identifier. The authority defaults to the x/gov module account.
+	CreateEpochInfo(context.Context, *MsgCreateEpochInfo) (*MsgCreateEpochInfoResponse, error)
+	// UpdateEpochDuration defines a (governance) operation for changing the
+	// duration of an existing epoch identifier. The new duration applies from
+	// the currently running epoch onwards.
+	UpdateEpochDuration(context.Context, *MsgUpdateEpochDuration) (*MsgUpdateEpochDurationResponse, error)
+	// DeleteEpochInfo defines a (governance) operation for removing an epoch
+	// identifier. Hooks are no longer called for a deleted identifier.
+	// Identifiers that registered hooks depend on cannot be deleted.
+	DeleteEpochInfo(context.Context, *MsgDeleteEpochInfo) (*MsgDeleteEpochInfoResponse, error)
+	// PauseEpoch defines a (governance) operation for pausing an epoch
+	// identifier. Paused epochs are neither started nor ended.
+	PauseEpoch(context.Context, *MsgPauseEpoch) (*MsgPauseEpochResponse, error)
+	// ResumeEpoch defines a (governance) operation for resuming a paused epoch
+	// identifier.
+	ResumeEpoch(context.Context, *MsgResumeEpoch) (*MsgResumeEpochResponse, error)
+	// ScheduleExecution defines a (governance) operation for executing
+	// authority-signed messages at the start of an epoch.
+	ScheduleExecution(context.Context, *MsgScheduleExecution) (*MsgScheduleExecutionResponse, error)
+	// CancelExecution defines a (governance) operation for cancelling a pending
+	// scheduled execution.
+	CancelExecution(context.Context, *MsgCancelExecution) (*MsgCancelExecutionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -61,6 +170,27 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) CreateEpochInfo(context.Context, *MsgCreateEpochInfo) (*MsgCreateEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEpochInfo not implemented")
+}
+func (UnimplementedMsgServer) UpdateEpochDuration(context.Context, *MsgUpdateEpochDuration) (*MsgUpdateEpochDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEpochDuration not implemented")
+}
+func (UnimplementedMsgServer) DeleteEpochInfo(context.Context, *MsgDeleteEpochInfo) (*MsgDeleteEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEpochInfo not implemented")
+}
+func (UnimplementedMsgServer) PauseEpoch(context.Context, *MsgPauseEpoch) (*MsgPauseEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseEpoch not implemented")
+}
+func (UnimplementedMsgServer) ResumeEpoch(context.Context, *MsgResumeEpoch) (*MsgResumeEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeEpoch not implemented")
+}
+func (UnimplementedMsgServer) ScheduleExecution(context.Context, *MsgScheduleExecution) (*MsgScheduleExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleExecution not implemented")
+}
+func (UnimplementedMsgServer) CancelExecution(context.Context, *MsgCancelExecution) (*MsgCancelExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelExecution not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +222,132 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Msg/CreateEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateEpochInfo(ctx, req.(*MsgCreateEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEpochDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEpochDuration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEpochDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Msg/UpdateEpochDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEpochDuration(ctx, req.(*MsgUpdateEpochDuration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteEpochInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Msg/DeleteEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteEpochInfo(ctx, req.(*MsgDeleteEpochInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Msg/PauseEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseEpoch(ctx, req.(*MsgPauseEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Msg/ResumeEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeEpoch(ctx, req.(*MsgResumeEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Msg/ScheduleExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleExecution(ctx, req.(*MsgScheduleExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelExecution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.epochs.Msg/CancelExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelExecution(ctx, req.(*MsgCancelExecution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +359,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CreateEpochInfo",
+			Handler:    _Msg_CreateEpochInfo_Handler,
+		},
+		{
+			MethodName: "UpdateEpochDuration",
+			Handler:    _Msg_UpdateEpochDuration_Handler,
+		},
+		{
+			MethodName: "DeleteEpochInfo",
+			Handler:    _Msg_DeleteEpochInfo_Handler,
+		},
+		{
+			MethodName: "PauseEpoch",
+			Handler:    _Msg_PauseEpoch_Handler,
+		},
+		{
+			MethodName: "ResumeEpoch",
+			Handler:    _Msg_ResumeEpoch_Handler,
+		},
+		{
+			MethodName: "ScheduleExecution",
+			Handler:    _Msg_ScheduleExecution_Handler,
+		},
+		{
+			MethodName: "CancelExecution",
+			Handler:    _Msg_CancelExecution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "galactica/epochs/tx.proto",
@@ -20,6 +20,42 @@ const _ = grpc.SupportPackageIsVersion7
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Period retrieves the current inflation period
+	Period(ctx context.Context, in *QueryPeriodRequest, opts ...grpc.CallOption) (*QueryPeriodResponse, error)
+	// EpochsPerPeriod retrieves the number of epochs of an inflation period
+	EpochsPerPeriod(ctx context.Context, in *QueryEpochsPerPeriodRequest, opts ...grpc.CallOption) (*QueryEpochsPerPeriodResponse, error)
+	// SkippedEpochs retrieves the number of epochs without inflation
+	SkippedEpochs(ctx context.Context, in *QuerySkippedEpochsRequest, opts ...grpc.CallOption) (*QuerySkippedEpochsResponse, error)
+	// EpochIdentifier retrieves the identifier of the epochs inflation is
+	// minted for
+	EpochIdentifier(ctx context.Context, in *QueryEpochIdentifierRequest, opts ...grpc.CallOption) (*QueryEpochIdentifierResponse, error)
+	// PeriodMintProvisions retrieves the mint provisions of all inflation
+	// periods
+	PeriodMintProvisions(ctx context.Context, in *QueryPeriodMintProvisionsRequest, opts ...grpc.CallOption) (*QueryPeriodMintProvisionsResponse, error)
+	// InflationDistribution retrieves the distribution of the minted coins
+	InflationDistribution(ctx context.Context, in *QueryInflationDistributionRequest, opts ...grpc.CallOption) (*QueryInflationDistributionResponse, error)
+	// EpochMintProvision retrieves the coins minted in an epoch of the current
+	// period
+	EpochMintProvision(ctx context.Context, in *QueryEpochMintProvisionRequest, opts ...grpc.CallOption) (*QueryEpochMintProvisionResponse, error)
+	// InflationRate retrieves the annualized inflation rate of the current
+	// period
+	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// CirculatingSupply retrieves the supply of the mint denom in circulation
+	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
+	// FailedEpochs retrieves the epochs whose inflation was not minted yet
+	FailedEpochs(ctx context.Context, in *QueryFailedEpochsRequest, opts ...grpc.CallOption) (*QueryFailedEpochsResponse, error)
+	// Remainder retrieves the remainder carried to the next epoch
+	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
+	// EmissionPreview retrieves the mint provisions of the next periods
+	EmissionPreview(ctx context.Context, in *QueryEmissionPreviewRequest, opts ...grpc.CallOption) (*QueryEmissionPreviewResponse, error)
+	// MaxSupply retrieves the max supply and the headroom left below it
+	MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error)
+	// DynamicInflation retrieves the multiplier of the epoch provision and the
+	// bonded ratio it responds to
+	DynamicInflation(ctx context.Context, in *QueryDynamicInflationRequest, opts ...grpc.CallOption) (*QueryDynamicInflationResponse, error)
+	// SimulateEmission projects the emission of the next epochs with proposed
+	// params without changing the state
+	SimulateEmission(ctx context.Context, in *QuerySimulateEmissionRequest, opts ...grpc.CallOption) (*QuerySimulateEmissionResponse, error)
 }
 
 type queryClient struct {
@@ -39,12 +75,183 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Period(ctx context.Context, in *QueryPeriodRequest, opts ...grpc.CallOption) (*QueryPeriodResponse, error) {
+	out := new(QueryPeriodResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/Period", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochsPerPeriod(ctx context.Context, in *QueryEpochsPerPeriodRequest, opts ...grpc.CallOption) (*QueryEpochsPerPeriodResponse, error) {
+	out := new(QueryEpochsPerPeriodResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EpochsPerPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SkippedEpochs(ctx context.Context, in *QuerySkippedEpochsRequest, opts ...grpc.CallOption) (*QuerySkippedEpochsResponse, error) {
+	out := new(QuerySkippedEpochsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/SkippedEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochIdentifier(ctx context.Context, in *QueryEpochIdentifierRequest, opts ...grpc.CallOption) (*QueryEpochIdentifierResponse, error) {
+	out := new(QueryEpochIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EpochIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PeriodMintProvisions(ctx context.Context, in *QueryPeriodMintProvisionsRequest, opts ...grpc.CallOption) (*QueryPeriodMintProvisionsResponse, error) {
+	out := new(QueryPeriodMintProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/PeriodMintProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationDistribution(ctx context.Context, in *QueryInflationDistributionRequest, opts ...grpc.CallOption) (*QueryInflationDistributionResponse, error) {
+	out := new(QueryInflationDistributionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/InflationDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochMintProvision(ctx context.Context, in *QueryEpochMintProvisionRequest, opts ...grpc.CallOption) (*QueryEpochMintProvisionResponse, error) {
+	out := new(QueryEpochMintProvisionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EpochMintProvision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error) {
+	out := new(QueryInflationRateResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/InflationRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error) {
+	out := new(QueryCirculatingSupplyResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/CirculatingSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedEpochs(ctx context.Context, in *QueryFailedEpochsRequest, opts ...grpc.CallOption) (*QueryFailedEpochsResponse, error) {
+	out := new(QueryFailedEpochsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/FailedEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
+	out := new(QueryRemainderResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/Remainder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionPreview(ctx context.Context, in *QueryEmissionPreviewRequest, opts ...grpc.CallOption) (*QueryEmissionPreviewResponse, error) {
+	out := new(QueryEmissionPreviewResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EmissionPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error) {
+	out := new(QueryMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/MaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DynamicInflation(ctx context.Context, in *QueryDynamicInflationRequest, opts ...grpc.CallOption) (*QueryDynamicInflationResponse, error) {
+	out := new(QueryDynamicInflationResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/DynamicInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateEmission(ctx context.Context, in *QuerySimulateEmissionRequest, opts ...grpc.CallOption) (*QuerySimulateEmissionResponse, error) {
+	out := new(QuerySimulateEmissionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/SimulateEmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Period retrieves the current inflation period
+	Period(context.Context, *QueryPeriodRequest) (*QueryPeriodResponse, error)
+	// EpochsPerPeriod retrieves the number of epochs of an inflation period
+	EpochsPerPeriod(context.Context, *QueryEpochsPerPeriodRequest) (*QueryEpochsPerPeriodResponse, error)
+	// SkippedEpochs retrieves the number of epochs without inflation
+	SkippedEpochs(context.Context, *QuerySkippedEpochsRequest) (*QuerySkippedEpochsResponse, error)
+	// EpochIdentifier retrieves the identifier of the epochs inflation is
+	// minted for
+	EpochIdentifier(context.Context, *QueryEpochIdentifierRequest) (*QueryEpochIdentifierResponse, error)
+	// PeriodMintProvisions retrieves the mint provisions of all inflation
+	// periods
+	PeriodMintProvisions(context.Context, *QueryPeriodMintProvisionsRequest) (*QueryPeriodMintProvisionsResponse, error)
+	// InflationDistribution retrieves the distribution of the minted coins
+	InflationDistribution(context.Context, *QueryInflationDistributionRequest) (*QueryInflationDistributionResponse, error)
+	// EpochMintProvision retrieves the coins minted in an epoch of the current
+	// period
+	EpochMintProvision(context.Context, *QueryEpochMintProvisionRequest) (*QueryEpochMintProvisionResponse, error)
+	// InflationRate retrieves the annualized inflation rate of the current
+	// period
+	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// CirculatingSupply retrieves the supply of the mint denom in circulation
+	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
+	// FailedEpochs retrieves the epochs whose inflation was not minted yet
+	FailedEpochs(context.Context, *QueryFailedEpochsRequest) (*QueryFailedEpochsResponse, error)
+	// Remainder retrieves the remainder carried to the next epoch
+	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
+	// EmissionPreview retrieves the mint provisions of the next periods
+	EmissionPreview(context.Context, *QueryEmissionPreviewRequest) (*QueryEmissionPreviewResponse, error)
+	// MaxSupply retrieves the max supply and the headroom left below it
+	MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error)
+	// DynamicInflation retrieves the multiplier of the epoch provision and the
+	// bonded ratio it responds to
+	DynamicInflation(context.Context, *QueryDynamicInflationRequest) (*QueryDynamicInflationResponse, error)
+	// SimulateEmission projects the emission of the next epochs with proposed
+	// params without changing the state
+	SimulateEmission(context.Context, *QuerySimulateEmissionRequest) (*QuerySimulateEmissionResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -55,6 +262,51 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) Period(context.Context, *QueryPeriodRequest) (*QueryPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Period not implemented")
+}
+func (UnimplementedQueryServer) EpochsPerPeriod(context.Context, *QueryEpochsPerPeriodRequest) (*QueryEpochsPerPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochsPerPeriod not implemented")
+}
+func (UnimplementedQueryServer) SkippedEpochs(context.Context, *QuerySkippedEpochsRequest) (*QuerySkippedEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkippedEpochs not implemented")
+}
+func (UnimplementedQueryServer) EpochIdentifier(context.Context, *QueryEpochIdentifierRequest) (*QueryEpochIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochIdentifier not implemented")
+}
+func (UnimplementedQueryServer) PeriodMintProvisions(context.Context, *QueryPeriodMintProvisionsRequest) (*QueryPeriodMintProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodMintProvisions not implemented")
+}
+func (UnimplementedQueryServer) InflationDistribution(context.Context, *QueryInflationDistributionRequest) (*QueryInflationDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationDistribution not implemented")
+}
+func (UnimplementedQueryServer) EpochMintProvision(context.Context, *QueryEpochMintProvisionRequest) (*QueryEpochMintProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochMintProvision not implemented")
+}
+func (UnimplementedQueryServer) InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
+}
+func (UnimplementedQueryServer) CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupply not implemented")
+}
+func (UnimplementedQueryServer) FailedEpochs(context.Context, *QueryFailedEpochsRequest) (*QueryFailedEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedEpochs not implemented")
+}
+func (UnimplementedQueryServer) Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
+}
+func (UnimplementedQueryServer) EmissionPreview(context.Context, *QueryEmissionPreviewRequest) (*QueryEmissionPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionPreview not implemented")
+}
+func (UnimplementedQueryServer) MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxSupply not implemented")
+}
+func (UnimplementedQueryServer) DynamicInflation(context.Context, *QueryDynamicInflationRequest) (*QueryDynamicInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicInflation not implemented")
+}
+func (UnimplementedQueryServer) SimulateEmission(context.Context, *QuerySimulateEmissionRequest) (*QuerySimulateEmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateEmission not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +338,276 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Period_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Period(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/Period",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Period(ctx, req.(*QueryPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochsPerPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochsPerPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochsPerPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EpochsPerPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochsPerPeriod(ctx, req.(*QueryEpochsPerPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SkippedEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkippedEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkippedEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/SkippedEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkippedEpochs(ctx, req.(*QuerySkippedEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EpochIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochIdentifier(ctx, req.(*QueryEpochIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PeriodMintProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodMintProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PeriodMintProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/PeriodMintProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PeriodMintProvisions(ctx, req.(*QueryPeriodMintProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/InflationDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationDistribution(ctx, req.(*QueryInflationDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochMintProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochMintProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochMintProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EpochMintProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochMintProvision(ctx, req.(*QueryEpochMintProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/InflationRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationRate(ctx, req.(*QueryInflationRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CirculatingSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/CirculatingSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupply(ctx, req.(*QueryCirculatingSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/FailedEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedEpochs(ctx, req.(*QueryFailedEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Remainder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/Remainder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Remainder(ctx, req.(*QueryRemainderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EmissionPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionPreview(ctx, req.(*QueryEmissionPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/MaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxSupply(ctx, req.(*QueryMaxSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DynamicInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/DynamicInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicInflation(ctx, req.(*QueryDynamicInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateEmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateEmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateEmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/SimulateEmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateEmission(ctx, req.(*QuerySimulateEmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +619,66 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Period",
+			Handler:    _Query_Period_Handler,
+		},
+		{
+			MethodName: "EpochsPerPeriod",
+			Handler:    _Query_EpochsPerPeriod_Handler,
+		},
+		{
+			MethodName: "SkippedEpochs",
+			Handler:    _Query_SkippedEpochs_Handler,
+		},
+		{
+			MethodName: "EpochIdentifier",
+			Handler:    _Query_EpochIdentifier_Handler,
+		},
+		{
+			MethodName: "PeriodMintProvisions",
+			Handler:    _Query_PeriodMintProvisions_Handler,
+		},
+		{
+			MethodName: "InflationDistribution",
+			Handler:    _Query_InflationDistribution_Handler,
+		},
+		{
+			MethodName: "EpochMintProvision",
+			Handler:    _Query_EpochMintProvision_Handler,
+		},
+		{
+			MethodName: "InflationRate",
+			Handler:    _Query_InflationRate_Handler,
+		},
+		{
+			MethodName: "CirculatingSupply",
+			Handler:    _Query_CirculatingSupply_Handler,
+		},
+		{
+			MethodName: "FailedEpochs",
+			Handler:    _Query_FailedEpochs_Handler,
+		},
+		{
+			MethodName: "Remainder",
+			Handler:    _Query_Remainder_Handler,
+		},
+		{
+			MethodName: "EmissionPreview",
+			Handler:    _Query_EmissionPreview_Handler,
+		},
+		{
+			MethodName: "MaxSupply",
+			Handler:    _Query_MaxSupply_Handler,
+		},
+		{
+			MethodName: "DynamicInflation",
+			Handler:    _Query_DynamicInflation_Handler,
+		},
+		{
+			MethodName: "SimulateEmission",
+			Handler:    _Query_SimulateEmission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "galactica/inflation/query.proto",
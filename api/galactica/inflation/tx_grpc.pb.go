@@ -23,6 +23,24 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddInflationShare defines a (governance) operation for adding a recipient
+	// of the inflation.
+	AddInflationShare(ctx context.Context, in *MsgAddInflationShare, opts ...grpc.CallOption) (*MsgAddInflationShareResponse, error)
+	// UpdateInflationShare defines a (governance) operation for changing the
+	// name and share of an inflation recipient.
+	UpdateInflationShare(ctx context.Context, in *MsgUpdateInflationShare, opts ...grpc.CallOption) (*MsgUpdateInflationShareResponse, error)
+	// RemoveInflationShare defines a (governance) operation for removing a
+	// recipient of the inflation.
+	RemoveInflationShare(ctx context.Context, in *MsgRemoveInflationShare, opts ...grpc.CallOption) (*MsgRemoveInflationShareResponse, error)
+	// SetValidatorsShare defines a (governance) operation for changing the share
+	// of the inflation allocated to validators.
+	SetValidatorsShare(ctx context.Context, in *MsgSetValidatorsShare, opts ...grpc.CallOption) (*MsgSetValidatorsShareResponse, error)
+	// RetryFailedEpoch defines a (governance) operation for minting and
+	// allocating the inflation of a failed epoch.
+	RetryFailedEpoch(ctx context.Context, in *MsgRetryFailedEpoch, opts ...grpc.CallOption) (*MsgRetryFailedEpochResponse, error)
+	// RedirectStrandedBalance defines a (governance) operation for sending the
+	// coins left in the inflation module account to a recipient.
+	RedirectStrandedBalance(ctx context.Context, in *MsgRedirectStrandedBalance, opts ...grpc.CallOption) (*MsgRedirectStrandedBalanceResponse, error)
 }
 
 type msgClient struct {
@@ -42,6 +60,60 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) AddInflationShare(ctx context.Context, in *MsgAddInflationShare, opts ...grpc.CallOption) (*MsgAddInflationShareResponse, error) {
+	out := new(MsgAddInflationShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/AddInflationShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateInflationShare(ctx context.Context, in *MsgUpdateInflationShare, opts ...grpc.CallOption) (*MsgUpdateInflationShareResponse, error) {
+	out := new(MsgUpdateInflationShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/UpdateInflationShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveInflationShare(ctx context.Context, in *MsgRemoveInflationShare, opts ...grpc.CallOption) (*MsgRemoveInflationShareResponse, error) {
+	out := new(MsgRemoveInflationShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/RemoveInflationShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorsShare(ctx context.Context, in *MsgSetValidatorsShare, opts ...grpc.CallOption) (*MsgSetValidatorsShareResponse, error) {
+	out := new(MsgSetValidatorsShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/SetValidatorsShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryFailedEpoch(ctx context.Context, in *MsgRetryFailedEpoch, opts ...grpc.CallOption) (*MsgRetryFailedEpochResponse, error) {
+	out := new(MsgRetryFailedEpochResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/RetryFailedEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedirectStrandedBalance(ctx context.Context, in *MsgRedirectStrandedBalance, opts ...grpc.CallOption) (*MsgRedirectStrandedBalanceResponse, error) {
+	out := new(MsgRedirectStrandedBalanceResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/RedirectStrandedBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -51,6 +123,24 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddInflationShare defines a (governance) operation for adding a recipient
+	// of the inflation.
+	AddInflationShare(context.Context, *MsgAddInflationShare) (*MsgAddInflationShareResponse, error)
+	// UpdateInflationShare defines a (governance) operation for changing the
+	// name and share of an inflation recipient.
+	UpdateInflationShare(context.Context, *MsgUpdateInflationShare) (*MsgUpdateInflationShareResponse, error)
+	// RemoveInflationShare defines a (governance) operation for removing a
+	// recipient of the inflation.
+	RemoveInflationShare(context.Context, *MsgRemoveInflationShare) (*MsgRemoveInflationShareResponse, error)
+	// SetValidatorsShare defines a (governance) operation for changing the share
+	// of the inflation allocated to validators.
+	SetValidatorsShare(context.Context, *MsgSetValidatorsShare) (*MsgSetValidatorsShareResponse, error)
+	// RetryFailedEpoch defines a (governance) operation for minting and
+	// allocating the inflation of a failed epoch.
+	RetryFailedEpoch(context.Context, *MsgRetryFailedEpoch) (*MsgRetryFailedEpochResponse, error)
+	// RedirectStrandedBalance defines a (governance) operation for sending the
+	// coins left in the inflation module account to a recipient.
+	RedirectStrandedBalance(context.Context, *MsgRedirectStrandedBalance) (*MsgRedirectStrandedBalanceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -61,6 +151,24 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) AddInflationShare(context.Context, *MsgAddInflationShare) (*MsgAddInflationShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInflationShare not implemented")
+}
+func (UnimplementedMsgServer) UpdateInflationShare(context.Context, *MsgUpdateInflationShare) (*MsgUpdateInflationShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInflationShare not implemented")
+}
+func (UnimplementedMsgServer) RemoveInflationShare(context.Context, *MsgRemoveInflationShare) (*MsgRemoveInflationShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInflationShare not implemented")
+}
+func (UnimplementedMsgServer) SetValidatorsShare(context.Context, *MsgSetValidatorsShare) (*MsgSetValidatorsShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorsShare not implemented")
+}
+func (UnimplementedMsgServer) RetryFailedEpoch(context.Context, *MsgRetryFailedEpoch) (*MsgRetryFailedEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedEpoch not implemented")
+}
+func (UnimplementedMsgServer) RedirectStrandedBalance(context.Context, *MsgRedirectStrandedBalance) (*MsgRedirectStrandedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectStrandedBalance not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +200,114 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddInflationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddInflationShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddInflationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/AddInflationShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddInflationShare(ctx, req.(*MsgAddInflationShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateInflationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInflationShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInflationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/UpdateInflationShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInflationShare(ctx, req.(*MsgUpdateInflationShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveInflationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveInflationShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveInflationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/RemoveInflationShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveInflationShare(ctx, req.(*MsgRemoveInflationShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorsShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorsShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorsShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/SetValidatorsShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorsShare(ctx, req.(*MsgSetValidatorsShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryFailedEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryFailedEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryFailedEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/RetryFailedEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryFailedEpoch(ctx, req.(*MsgRetryFailedEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedirectStrandedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedirectStrandedBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedirectStrandedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/RedirectStrandedBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedirectStrandedBalance(ctx, req.(*MsgRedirectStrandedBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +319,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "AddInflationShare",
+			Handler:    _Msg_AddInflationShare_Handler,
+		},
+		{
+			MethodName: "UpdateInflationShare",
+			Handler:    _Msg_UpdateInflationShare_Handler,
+		},
+		{
+			MethodName: "RemoveInflationShare",
+			Handler:    _Msg_RemoveInflationShare_Handler,
+		},
+		{
+			MethodName: "SetValidatorsShare",
+			Handler:    _Msg_SetValidatorsShare_Handler,
+		},
+		{
+			MethodName: "RetryFailedEpoch",
+			Handler:    _Msg_RetryFailedEpoch_Handler,
+		},
+		{
+			MethodName: "RedirectStrandedBalance",
+			Handler:    _Msg_RedirectStrandedBalance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "galactica/inflation/tx.proto",
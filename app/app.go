@@ -726,6 +726,7 @@ func (app *App) applyUpgrades() {
 	app.applyUpgrade_v0_1_2()
 	app.applyUpgrade_v0_2_4()
 	app.applyUpgrade_v0_2_7()
+	app.applyUpgrade_v0_3_0()
 	// app.applyUpgrade_v0_2_3()
 }
 
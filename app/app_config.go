@@ -110,8 +110,9 @@ var (
 		genutiltypes.ModuleName,
 		consensustypes.ModuleName,
 		reputationmoduletypes.ModuleName,
-		inflationmoduletypes.ModuleName,
+		// NOTE: inflation genesis is validated against the epochs state
 		epochsmoduletypes.ModuleName,
+		inflationmoduletypes.ModuleName,
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
 		// this line is used by starport scaffolding # stargate/app/initGenesis
@@ -198,7 +199,8 @@ var (
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
-		{Account: inflationmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner, authtypes.Staking}},
+		// inflation mints the epoch provisions and burns the burn shares
+		{Account: inflationmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: evmtypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
@@ -210,6 +212,8 @@ var (
 		minttypes.ModuleName,
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
+		// the inflation module account must only hold the carried remainder
+		inflationmoduletypes.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
 	}
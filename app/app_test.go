@@ -0,0 +1,52 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	cosmosdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/app"
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	inflationtypes "github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestInflationModuleAccountBlocked(t *testing.T) {
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	bApp := app.New(log.NewNopLogger(), cosmosdb.NewMemDB(), nil, true, appOptions, baseapp.SetChainID(SimAppChainID))
+
+	// coins sent to the inflation module account would break its module
+	// balance invariant
+	moduleAddr := authtypes.NewModuleAddress(inflationtypes.ModuleName)
+	require.True(t, bApp.BankKeeper.BlockedAddr(moduleAddr))
+
+	ctx := bApp.NewContext(true)
+	require.NoError(t, bApp.BankKeeper.SetParams(ctx, banktypes.DefaultParams()))
+	msg := banktypes.NewMsgSend(sdk.MustAccAddressFromBech32(sample.AccAddress()), moduleAddr, sdk.NewCoins(sdk.NewInt64Coin("agnet", 1)))
+	_, err := bankkeeper.NewMsgServerImpl(bApp.BankKeeper).Send(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
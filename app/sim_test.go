@@ -35,6 +35,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -51,10 +52,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/Galactica-corp/galactica/app"
+	epochstypes "github.com/Galactica-corp/galactica/x/epochs/types"
 )
 
 const (
-	SimAppChainID = "galactica-simapp"
+	SimAppChainID = "galactica_9000-1"
 )
 
 type StoreKeysPrefixes struct {
@@ -66,6 +68,12 @@ type StoreKeysPrefixes struct {
 // Get flags every time the simulator is run
 func init() {
 	simcli.GetSimulatorFlags()
+
+	// the app's module addresses use the chain's bech32 prefixes
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(app.AccountAddressPrefix, app.AccountAddressPrefix+"pub")
+	config.SetBech32PrefixForValidator(app.AccountAddressPrefix+"valoper", app.AccountAddressPrefix+"valoperpub")
+	config.SetBech32PrefixForConsensusNode(app.AccountAddressPrefix+"valcons", app.AccountAddressPrefix+"valconspub")
 }
 
 // fauxMerkleModeOpt returns a BaseApp option to use a dbStoreAdapter instead of
@@ -201,20 +209,15 @@ func TestAppImportExport(t *testing.T) {
 	err = json.Unmarshal(exported.AppState, &genesisState)
 	require.NoError(t, err)
 
-	defer func() {
-		if r := recover(); r != nil {
-			err := fmt.Sprintf("%v", r)
-			if !strings.Contains(err, "validator set is empty after InitGenesis") {
-				panic(r)
-			}
-			logger.Info("Skipping simulation as all validators have been unbonded")
-			logger.Info("err", err, "stacktrace", string(debug.Stack()))
-		}
-	}()
-
 	ctxA := bApp.NewContext(true).WithBlockHeader(tmproto.Header{Height: bApp.LastBlockHeight()})
 	ctxB := newApp.NewContext(true).WithBlockHeader(tmproto.Header{Height: bApp.LastBlockHeight()})
-	newApp.ModuleManager.InitGenesis(ctxB, bApp.AppCodec(), genesisState)
+	_, err = newApp.ModuleManager.InitGenesis(ctxB, bApp.AppCodec(), genesisState)
+	if err != nil && strings.Contains(err.Error(), "validator set is empty after InitGenesis") {
+		logger.Info("Skipping simulation as all validators have been unbonded")
+		logger.Info("err", err, "stacktrace", string(debug.Stack()))
+		return
+	}
+	require.NoError(t, err)
 	newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
 
 	fmt.Printf("comparing stores...\n")
@@ -237,6 +240,10 @@ func TestAppImportExport(t *testing.T) {
 		{bApp.GetKey(evidencetypes.StoreKey), newApp.GetKey(evidencetypes.StoreKey), [][]byte{}},
 		{bApp.GetKey(capabilitytypes.StoreKey), newApp.GetKey(capabilitytypes.StoreKey), [][]byte{}},
 		{bApp.GetKey(authzkeeper.StoreKey), newApp.GetKey(authzkeeper.StoreKey), [][]byte{authzkeeper.GrantKey, authzkeeper.GrantQueuePrefix}},
+		{
+			bApp.GetKey(epochstypes.StoreKey), newApp.GetKey(epochstypes.StoreKey),
+			[][]byte{epochstypes.KeyLastBlockTime, epochstypes.KeyAverageBlockTime, epochstypes.KeyNextScheduledExecutionID},
+		}, // block times are not exported and the next id is always set on import
 	}
 
 	for _, skp := range storeKeysPrefixes {
@@ -375,7 +382,7 @@ func TestAppStateDeterminism(t *testing.T) {
 			} else {
 				logger = log.NewNopLogger()
 			}
-			chainID := fmt.Sprintf("chain-id-%d-%d", i, j)
+			chainID := fmt.Sprintf("galactica_%d-1", 9000+i)
 			config.ChainID = chainID
 
 			db := cosmosdb.NewMemDB()
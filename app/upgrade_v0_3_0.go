@@ -0,0 +1,52 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	inflationtypes "github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+const (
+	planName_v0_3_0 = "0.3.0"
+)
+
+func (app *App) applyUpgrade_v0_3_0() {
+	app.UpgradeKeeper.SetUpgradeHandler(planName_v0_3_0, app.upgradeHandler_v0_3_0())
+}
+
+// upgradeHandler_v0_3_0 runs the module migrations, which move the inflation
+// distribution of x/inflation into its params, and sweeps the coins stranded
+// in the inflation module account into the community pool. The module account
+// can no longer receive funds and must only hold the carried remainder.
+func (app *App) upgradeHandler_v0_3_0() func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		logger := sdk.UnwrapSDKContext(ctx).Logger()
+
+		logger.Info("Starting module migrations...")
+
+		vm, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		if err != nil {
+			return vm, err
+		}
+
+		swept, err := app.InflationKeeper.SweepStrandedBalance(sdk.UnwrapSDKContext(ctx), inflationtypes.RecipientCommunityPool)
+		switch {
+		case errors.Is(err, inflationtypes.ErrNoStrandedBalance):
+			logger.Info("No stranded inflation module balance to sweep")
+		case err != nil:
+			return vm, err
+		default:
+			logger.Info("Swept stranded inflation module balance", "amount", swept.String())
+		}
+
+		logger.Info("Upgrade " + plan.Name + " complete")
+
+		return vm, nil
+	}
+}
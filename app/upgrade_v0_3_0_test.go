@@ -0,0 +1,63 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	cosmosdb "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	inflationtypes "github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestUpgradeHandler_v0_3_0(t *testing.T) {
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = t.TempDir()
+	app := New(log.NewNopLogger(), cosmosdb.NewMemDB(), nil, true, appOptions)
+
+	ctx := app.NewContext(true)
+	require.NoError(t, app.DistrKeeper.FeePool.Set(ctx, distrtypes.InitialFeePool()))
+	handler := app.upgradeHandler_v0_3_0()
+	plan := upgradetypes.Plan{Name: planName_v0_3_0}
+	fromVM := app.ModuleManager.GetVersionMap()
+	moduleAddr := authtypes.NewModuleAddress(inflationtypes.ModuleName)
+
+	// nothing is stranded besides the carried remainder
+	remainder := sdk.NewCoins(sdk.NewInt64Coin("agnet", 7))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, inflationtypes.ModuleName, remainder))
+	app.InflationKeeper.SetRemainder(ctx, remainder)
+	_, err := handler(ctx, plan, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, remainder, app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+
+	// stranded coins are swept into the community pool
+	stranded := sdk.NewCoins(sdk.NewInt64Coin("agnet", 100))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, inflationtypes.ModuleName, stranded))
+	_, err = handler(ctx, plan, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, remainder, app.BankKeeper.GetAllBalances(ctx, moduleAddr))
+
+	feePool, err := app.DistrKeeper.FeePool.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(stranded...), feePool.CommunityPool)
+}
@@ -157,11 +157,6 @@ function configure_genesis() {
     update_genesis_json '.app_state.inflation.params.inflation_distribution.other_shares = [
       {"address": "'$faucet_address'","name": "faucet","share": "'$inflation_faucet_share'"}
     ]'
-
-    update_genesis_json '.app_state.inflation.inflation_distribution.validators_share = "'$inflation_validators_share'"'
-    update_genesis_json '.app_state.inflation.inflation_distribution.other_shares = [
-      {"address": "'$faucet_address'","name": "faucet","share": "'$inflation_faucet_share'"}
-    ]'
 }
 
 function add_genesis_account() {
@@ -0,0 +1,71 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// AccountKeeper is an in-memory account keeper for keeper tests of modules
+// that look up module accounts
+type AccountKeeper struct {
+	Modules map[string]bool
+}
+
+// NewAccountKeeper returns an account keeper with the given module accounts
+func NewAccountKeeper(modules ...string) *AccountKeeper {
+	a := &AccountKeeper{Modules: make(map[string]bool)}
+	for _, module := range modules {
+		a.Modules[module] = true
+	}
+	return a
+}
+
+func (a *AccountKeeper) GetAccount(_ context.Context, _ sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+func (a *AccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	if !a.Modules[name] {
+		return nil
+	}
+	return authtypes.NewModuleAddress(name)
+}
+
+// DistrKeeper is a distribution keeper that keeps the community pool in the
+// distribution module account of a BankKeeper
+type DistrKeeper struct {
+	Bank *BankKeeper
+}
+
+// NewDistrKeeper returns a distribution keeper using the bank keeper
+func NewDistrKeeper(bank *BankKeeper) *DistrKeeper {
+	return &DistrKeeper{Bank: bank}
+}
+
+func (d *DistrKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return d.Bank.send(ctx, sender, authtypes.NewModuleAddress(distrtypes.ModuleName), amount)
+}
+
+// CommunityPool returns the coins of the community pool
+func (d *DistrKeeper) CommunityPool(ctx context.Context) sdk.Coins {
+	return d.Bank.GetAllBalances(ctx, authtypes.NewModuleAddress(distrtypes.ModuleName))
+}
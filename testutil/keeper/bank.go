@@ -0,0 +1,136 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	bankBalancePrefix = []byte("balance/")
+	bankSupplyKey     = []byte("supply")
+)
+
+// BankKeeper is a bank keeper for keeper tests of modules that mint and send
+// coins. Balances and supply are kept in its own store, which the test
+// keepers mount, so that they are discarded together with a cached context.
+type BankKeeper struct {
+	Metadata map[string]banktypes.Metadata
+
+	storeKey *storetypes.KVStoreKey
+}
+
+// NewBankKeeper returns a bank keeper without balances
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{
+		Metadata: make(map[string]banktypes.Metadata),
+		storeKey: storetypes.NewKVStoreKey(banktypes.StoreKey),
+	}
+}
+
+// StoreKey returns the key of the store that has to be mounted for the keeper
+func (b *BankKeeper) StoreKey() *storetypes.KVStoreKey {
+	return b.storeKey
+}
+
+func (b *BankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.GetAllBalances(ctx, addr)
+}
+
+func (b *BankKeeper) MintCoins(ctx context.Context, name string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(name)
+	b.set(ctx, append(bankBalancePrefix, addr...), b.GetAllBalances(ctx, addr).Add(amt...))
+	b.set(ctx, bankSupplyKey, b.get(ctx, bankSupplyKey).Add(amt...))
+	return nil
+}
+
+func (b *BankKeeper) BurnCoins(ctx context.Context, name string, amt sdk.Coins) error {
+	if err := b.sub(ctx, authtypes.NewModuleAddress(name), amt); err != nil {
+		return err
+	}
+	b.set(ctx, bankSupplyKey, b.get(ctx, bankSupplyKey).Sub(amt...))
+	return nil
+}
+
+func (b *BankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.GetAllBalances(ctx, addr).AmountOf(denom))
+}
+
+func (b *BankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.get(ctx, append(bankBalancePrefix, addr...))
+}
+
+func (b *BankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *BankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(ctx, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *BankKeeper) HasSupply(ctx context.Context, denom string) bool {
+	return b.get(ctx, bankSupplyKey).AmountOf(denom).IsPositive()
+}
+
+func (b *BankKeeper) GetSupply(ctx context.Context, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.get(ctx, bankSupplyKey).AmountOf(denom))
+}
+
+func (b *BankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	metadata, found := b.Metadata[denom]
+	return metadata, found
+}
+
+func (b *BankKeeper) send(ctx context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if err := b.sub(ctx, from, amt); err != nil {
+		return err
+	}
+	b.set(ctx, append(bankBalancePrefix, to...), b.GetAllBalances(ctx, to).Add(amt...))
+	return nil
+}
+
+func (b *BankKeeper) sub(ctx context.Context, addr sdk.AccAddress, amt sdk.Coins) error {
+	balances := b.GetAllBalances(ctx, addr)
+	balance, negative := balances.SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", balances, amt)
+	}
+	b.set(ctx, append(bankBalancePrefix, addr...), balance)
+	return nil
+}
+
+func (b *BankKeeper) get(ctx context.Context, key []byte) sdk.Coins {
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(b.storeKey).Get(key)
+	if bz == nil {
+		return nil
+	}
+	coins, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return coins
+}
+
+func (b *BankKeeper) set(ctx context.Context, key []byte, coins sdk.Coins) {
+	sdk.UnwrapSDKContext(ctx).KVStore(b.storeKey).Set(key, []byte(coins.String()))
+}
@@ -19,6 +19,7 @@ import (
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
 	sdktypes "cosmossdk.io/store/types"
 	storetypes "cosmossdk.io/store/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -27,6 +28,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/require"
 
@@ -35,14 +37,47 @@ import (
 )
 
 func InflationKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return InflationKeeperWithBank(t, nil)
+}
+
+// InflationKeeperWithBank returns an inflation keeper using the given bank
+// keeper
+func InflationKeeperWithBank(t testing.TB, bankKeeper types.BankKeeper) (keeper.Keeper, sdk.Context) {
+	return InflationKeeperWithKeepers(t, NewInflationAccountKeeper(), bankKeeper, nil, nil, nil)
+}
+
+// NewInflationAccountKeeper returns an account keeper with the module accounts
+// that receive inflation in keeper tests
+func NewInflationAccountKeeper() *AccountKeeper {
+	return NewAccountKeeper(
+		types.ModuleName,
+		authtypes.FeeCollectorName,
+		distrtypes.ModuleName,
+		govtypes.ModuleName,
+	)
+}
+
+// InflationKeeperWithKeepers returns an inflation keeper using the given
+// account, bank, distribution, epochs and staking keepers
+func InflationKeeperWithKeepers(
+	t testing.TB,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	epochsKeeper types.EpochsKeeper,
+	stakingKeeper types.StakingKeeper,
+) (keeper.Keeper, sdk.Context) {
 	storeKey := sdktypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 	logger := log.NewNopLogger()
 
 	db := dbm.NewMemDB()
-	stateStore := store.NewCommitMultiStore(db, logger, nil)
+	stateStore := store.NewCommitMultiStore(db, logger, metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	if bank, ok := bankKeeper.(*BankKeeper); ok {
+		stateStore.MountStoreWithDB(bank.StoreKey(), storetypes.StoreTypeIAVL, db)
+	}
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -54,8 +89,11 @@ func InflationKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		storeKey,
 		memStoreKey,
 		authority.String(),
-		nil,
-		nil,
+		accountKeeper,
+		bankKeeper,
+		distrKeeper,
+		epochsKeeper,
+		stakingKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -0,0 +1,51 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+)
+
+// StakingKeeper is a staking keeper for keeper tests of modules that respond
+// to the bonded ratio
+type StakingKeeper struct {
+	Supply math.Int
+	Bonded math.Int
+}
+
+// NewStakingKeeper returns a staking keeper with the given bonded tokens out of
+// the staking token supply
+func NewStakingKeeper(bonded, supply int64) *StakingKeeper {
+	return &StakingKeeper{Supply: math.NewInt(supply), Bonded: math.NewInt(bonded)}
+}
+
+func (s *StakingKeeper) TotalBondedTokens(_ context.Context) (math.Int, error) {
+	return s.Bonded, nil
+}
+
+func (s *StakingKeeper) BondedRatio(_ context.Context) (math.LegacyDec, error) {
+	if !s.Supply.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+	return math.LegacyNewDecFromInt(s.Bonded).QuoInt(s.Supply), nil
+}
+
+func (s *StakingKeeper) StakingTokenSupply(_ context.Context) (math.Int, error) {
+	return s.Supply, nil
+}
@@ -0,0 +1,96 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package epochs
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	epochsv1 "github.com/Galactica-corp/galactica/api/galactica/epochs"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. The
+// hand-written query commands in client/cli are kept, while the governance
+// messages are generated from the Msg service.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              epochsv1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "CreateEpochInfo",
+					Use:            "create-epoch-info [identifier] [duration]",
+					Short:          "Register a new epoch identifier (authority only)",
+					Example:        fmt.Sprintf("$ %s tx epochs create-epoch-info week 168h --start-time 2024-01-01T00:00:00Z\n$ %s tx epochs create-epoch-info thousand_blocks 0s --duration-blocks 1000\n$ %s tx epochs create-epoch-info month 0s --alignment month", version.AppName, version.AppName, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"start_time":      {Name: "start-time", Usage: "start time of the epoch (RFC3339), defaults to the execution block time"},
+						"duration_blocks": {Name: "duration-blocks", Usage: "length of the epoch in blocks, requires a zero duration"},
+						"alignment":       {Name: "alignment", Usage: "align the epoch to UTC calendar days, weeks (Monday) or months, requires a zero duration"},
+					},
+				},
+				{
+					RpcMethod:      "UpdateEpochDuration",
+					Use:            "update-epoch-duration [identifier] [duration]",
+					Short:          "Change the duration of an existing epoch identifier (authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"duration_blocks": {Name: "duration-blocks", Usage: "length of the epoch in blocks, requires a zero duration"},
+					},
+				},
+				{
+					RpcMethod:      "DeleteEpochInfo",
+					Use:            "delete-epoch-info [identifier]",
+					Short:          "Remove an epoch identifier (authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+				},
+				{
+					RpcMethod:      "PauseEpoch",
+					Use:            "pause-epoch [identifier]",
+					Short:          "Pause an epoch identifier (authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+				},
+				{
+					RpcMethod:      "ResumeEpoch",
+					Use:            "resume-epoch [identifier]",
+					Short:          "Resume a paused epoch identifier (authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"reanchor": {Name: "reanchor", Usage: "restart the running epoch at the resume block instead of continuing the original schedule"},
+					},
+				},
+				{
+					RpcMethod: "ScheduleExecution",
+					Skip:      true, // skipped because the messages are submitted through a governance proposal
+				},
+				{
+					RpcMethod:      "CancelExecution",
+					Use:            "cancel-execution [id]",
+					Short:          "Cancel a pending scheduled execution (authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+			},
+		},
+	}
+}
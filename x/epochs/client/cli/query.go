@@ -44,6 +44,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdQueryParams(),
 		GetCmdEpochsInfos(),
 		GetCmdCurrentEpoch(),
+		GetCmdEpochInfo(),
+		GetCmdNextEpoch(),
+		GetCmdEpochHistory(),
+		GetCmdEpochStats(),
+		GetCmdScheduledExecutions(),
+		GetCmdScheduledExecution(),
 	)
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,76 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// GetCmdEpochStats provides the chain activity statistics of the current or a
+// completed epoch of the specified identifier
+func GetCmdEpochStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "epoch-stats [identifier] [epoch-number]",
+		Short: "Query the tx count, gas used and block count of the current or a completed epoch",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs epoch-stats week
+$ %s query epochs epoch-stats week 12`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var epochNumber int64
+			if len(args) > 1 {
+				epochNumber, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid epoch number %s: %w", args[1], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EpochStats(cmd.Context(), &types.QueryEpochStatsRequest{
+				Identifier:  args[0],
+				EpochNumber: epochNumber,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,114 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// GetCmdScheduledExecutions provides the pending scheduled executions,
+// optionally filtered by epoch identifier
+func GetCmdScheduledExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-executions [identifier]",
+		Short: "Query the pending scheduled executions, optionally of the specified identifier",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs scheduled-executions
+$ %s query epochs scheduled-executions day`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryScheduledExecutionsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.Identifier = args[0]
+			}
+
+			res, err := queryClient.ScheduledExecutions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled-executions")
+
+	return cmd
+}
+
+// GetCmdScheduledExecution provides the pending scheduled execution of the
+// specified id
+func GetCmdScheduledExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-execution [id]",
+		Short: "Query the pending scheduled execution of the specified id",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query epochs scheduled-execution 1`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid scheduled execution id %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ScheduledExecution(cmd.Context(), &types.QueryScheduledExecutionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
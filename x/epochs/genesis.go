@@ -37,17 +37,40 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			epoch.StartTime = ctx.BlockTime()
 		}
 
-		epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		// epochs exported from a running chain keep their start height, unless
+		// the chain restarts at a lower height, e.g. after a zero height export
+		if !epoch.EpochCountingStarted || epoch.CurrentEpochStartHeight > ctx.BlockHeight() {
+			epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+		}
 
 		k.SetEpochInfo(ctx, epoch)
 	}
+
+	for _, record := range genState.History {
+		k.SetEpochRecord(ctx, record)
+	}
+
+	nextID := genState.NextScheduledExecutionId
+	for _, execution := range genState.ScheduledExecutions {
+		k.SetScheduledExecution(ctx, execution)
+		if execution.Id >= nextID {
+			nextID = execution.Id + 1
+		}
+	}
+	if nextID > 0 {
+		k.SetNextScheduledExecutionID(ctx, nextID)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := &types.GenesisState{
-		Epochs: k.AllEpochInfos(ctx),
-		Params: k.GetParams(ctx),
+		Epochs:  k.AllEpochInfos(ctx),
+		Params:  k.GetParams(ctx),
+		History: k.AllEpochRecords(ctx),
+
+		ScheduledExecutions:      k.AllScheduledExecutions(ctx),
+		NextScheduledExecutionId: k.GetNextScheduledExecutionID(ctx),
 	}
 
 	// this line is used by starport scaffolding # genesis/module/export
@@ -16,29 +16,99 @@ package epochs_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
 	"github.com/Galactica-corp/galactica/testutil/nullify"
 	"github.com/Galactica-corp/galactica/x/epochs"
+	"github.com/Galactica-corp/galactica/x/epochs/keeper"
 	"github.com/Galactica-corp/galactica/x/epochs/types"
 )
 
 func TestGenesis(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+
+	execution, err := types.NewScheduledExecution(3, types.DayEpochID, 2, []sdk.Msg{
+		&types.MsgPauseEpoch{Authority: k.GetAuthority(), Identifier: types.WeekEpochID},
+	})
+	require.NoError(t, err)
+
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		History: []types.EpochRecord{
+			{
+				Identifier:  types.DayEpochID,
+				EpochNumber: 1,
+				StartTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndTime:     time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+				StartHeight: 1,
+				EndHeight:   17281,
+			},
+		},
+		ScheduledExecutions:      []types.ScheduledExecution{execution},
+		NextScheduledExecutionId: 5,
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
-	k, ctx := keepertest.EpochsKeeper(t)
 	epochs.InitGenesis(ctx, k, genesisState)
 	got := epochs.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
 
+	require.Equal(t, genesisState.History, got.History)
+	require.Equal(t, uint64(5), got.NextScheduledExecutionId)
+	require.Len(t, got.ScheduledExecutions, 1)
+	msgs, err := got.ScheduledExecutions[0].GetMsgs()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{&types.MsgPauseEpoch{Authority: k.GetAuthority(), Identifier: types.WeekEpochID}}, msgs)
+
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
-
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestInitGenesisStartedEpochAtLowerHeight(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(startTime.Add(time.Hour))
+
+	epochs.InitGenesis(ctx, k, types.GenesisState{
+		Params: types.DefaultParams(),
+		Epochs: []types.EpochInfo{
+			{
+				Identifier:              types.DayEpochID,
+				StartTime:               startTime,
+				Duration:                time.Hour * 24,
+				CurrentEpoch:            3,
+				CurrentEpochStartTime:   startTime,
+				CurrentEpochStartHeight: 50,
+				EpochCountingStarted:    true,
+			},
+			{
+				Identifier:              "blocks",
+				StartTime:               startTime,
+				DurationBlocks:          10,
+				CurrentEpoch:            2,
+				CurrentEpochStartTime:   startTime,
+				CurrentEpochStartHeight: 40,
+				EpochCountingStarted:    true,
+			},
+		},
+	})
+
+	// the start height of the exported chain is ahead of the restarted chain
+	for _, epochInfo := range k.AllEpochInfos(ctx) {
+		require.Equal(t, int64(1), epochInfo.CurrentEpochStartHeight, epochInfo.Identifier)
+	}
+	msg, broken := keeper.EpochStartInvariant(k)(ctx)
+	require.False(t, broken, msg)
+
+	// block-based epochs end after their duration from the restart
+	k.BeginBlocker(ctx.WithBlockHeight(11))
+	epochInfo, found := k.GetEpochInfo(ctx, "blocks")
+	require.True(t, found)
+	require.Equal(t, int64(3), epochInfo.CurrentEpoch)
+}
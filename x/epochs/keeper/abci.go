@@ -32,50 +32,152 @@ func (k Keeper) BeginBlocker(goCtx context.Context) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := k.Logger(ctx)
+	params := k.GetParams(ctx)
+
+	k.TrackBlockTime(ctx)
 
 	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+		if epochInfo.Paused {
+			return false
+		}
+
 		// Has it not started, and is the block time > initial epoch start time
 		shouldInitialEpochStart := !epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
 
-		epochEndTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
-		shouldEpochEnd := ctx.BlockTime().After(epochEndTime) && !shouldInitialEpochStart && !epochInfo.StartTime.After(ctx.BlockTime())
-
-		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
+		// only running epochs can be over, missed epochs are not counted for
+		// epochs that have not started yet
+		hasStarted := epochInfo.EpochCountingStarted && !epochInfo.StartTime.After(ctx.BlockTime())
 
 		switch {
 		case shouldInitialEpochStart:
 			epochInfo.StartInitialEpoch()
+			epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
 
 			logger.Info("starting epoch", "identifier", epochInfo.Identifier)
-		case shouldEpochEnd:
-			epochInfo.EndEpoch()
-
-			logger.Info("ending epoch", "identifier", epochInfo.Identifier)
-
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(
-					types.EventTypeEpochEnd,
-					sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				),
-			)
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
-		default:
-			// continue
-			return false
+
+			k.SetEpochInfo(ctx, epochInfo)
+			k.emitEpochStart(ctx, epochInfo)
+			k.BeforeEpochStart(ctx, epochInfo.StartingEpochContext())
+		case hasStarted:
+			if missed := epochInfo.MissedEpochs(ctx.BlockHeight(), ctx.BlockTime()); missed > 0 {
+				k.processEpochEnds(ctx, params, epochInfo, missed)
+			}
 		}
 
-		k.SetEpochInfo(ctx, epochInfo)
+		return false
+	})
+}
 
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeEpochStart,
-				sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
-				sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
-			),
-		)
+// EndBlocker of epochs module adds the block to the stats of all running
+// epochs. The block that starts an epoch is the first block counted for it.
+func (k Keeper) EndBlocker(goCtx context.Context) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	ctx := sdk.UnwrapSDKContext(goCtx)
 
-		k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+	// tx count and gas used are set on the context by the base app once all
+	// transactions of the block have been executed
+	txCount := uint64(ctx.TxCount())
+	gasUsed := ctx.BlockGasUsed()
 
+	k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+		if !epochInfo.IsRunning() {
+			return false
+		}
+
+		epochInfo.Stats.TrackBlock(txCount, gasUsed)
+		k.SetEpochInfo(ctx, epochInfo)
 		return false
 	})
 }
+
+// processEpochEnds ends the current epoch and handles any further epochs that
+// were missed, e.g. after a chain halt, according to the catch up policy.
+func (k Keeper) processEpochEnds(ctx sdk.Context, params types.Params, epochInfo types.EpochInfo, missed int64) {
+	var processed, skipped int64
+	switch params.CatchUpPolicy {
+	case types.CatchUpPolicyAll:
+		processed = missed
+	case types.CatchUpPolicySkip:
+		processed = 1
+		skipped = missed - 1
+	default:
+		processed = min(missed, params.EpochsPerBlock())
+	}
+
+	for i := int64(0); i < processed; i++ {
+		skip := int64(0)
+		if i == 0 {
+			skip = skipped
+		}
+		k.advanceEpoch(ctx, params, &epochInfo, skip)
+	}
+
+	if missed > 1 {
+		k.AfterEpochCatchUp(ctx, epochInfo.Identifier, params.CatchUpPolicy, processed, skipped)
+	}
+}
+
+// advanceEpoch ends the current epoch and starts the next one, calling the
+// epoch hooks in between. The given number of epochs following the ended one
+// are skipped without calling hooks.
+func (k Keeper) advanceEpoch(ctx sdk.Context, params types.Params, epochInfo *types.EpochInfo, skip int64) {
+	ended := *epochInfo
+	endTime := ended.EpochEndTime()
+
+	epochInfo.EndEpoch()
+	if epochInfo.IsBlockBased() {
+		endTime = ctx.BlockTime()
+		epochInfo.CurrentEpochStartTime = endTime
+	}
+	epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
+
+	record := k.recordEpoch(ctx, params, ended, endTime)
+
+	if skip > 0 {
+		k.skipEpochs(ctx, epochInfo, skip)
+	}
+
+	k.Logger(ctx).Info("ending epoch", "identifier", epochInfo.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeTxCount, strconv.FormatUint(record.Stats.TxCount, 10)),
+			sdk.NewAttribute(types.AttributeGasUsed, strconv.FormatUint(record.Stats.GasUsed, 10)),
+			sdk.NewAttribute(types.AttributeBlockCount, strconv.FormatUint(record.Stats.BlockCount, 10)),
+		),
+	)
+	k.AfterEpochEnd(ctx, record.EpochContext())
+
+	k.SetEpochInfo(ctx, *epochInfo)
+	k.emitEpochStart(ctx, *epochInfo)
+	k.BeforeEpochStart(ctx, epochInfo.StartingEpochContext())
+}
+
+// skipEpochs jumps over the given number of epochs, which are neither recorded
+// nor passed to the hooks
+func (k Keeper) skipEpochs(ctx sdk.Context, epochInfo *types.EpochInfo, skip int64) {
+	epochInfo.SkipEpochs(skip)
+
+	k.Logger(ctx).Info("skipping missed epochs", "identifier", epochInfo.Identifier, "skipped", skip)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochSkipped,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochInfo.Identifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeSkippedEpochs, strconv.FormatInt(skip, 10)),
+		),
+	)
+}
+
+func (k Keeper) emitEpochStart(ctx sdk.Context, epochInfo types.EpochInfo) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochInfo.CurrentEpoch, 10)),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epochInfo.CurrentEpochStartTime.Unix(), 10)),
+		),
+	)
+}
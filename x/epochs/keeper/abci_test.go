@@ -0,0 +1,322 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+type recordingHooks struct {
+	ended     []int64
+	started   []int64
+	lastEnded types.EpochContext
+	policy    types.CatchUpPolicy
+	processed int64
+	skipped   int64
+	catchUps  int
+}
+
+func (h *recordingHooks) AfterEpochEnd(_ sdk.Context, epoch types.EpochContext) error {
+	h.ended = append(h.ended, epoch.EpochNumber)
+	h.lastEnded = epoch
+	return nil
+}
+
+func (h *recordingHooks) BeforeEpochStart(_ sdk.Context, epoch types.EpochContext) error {
+	h.started = append(h.started, epoch.EpochNumber)
+	return nil
+}
+
+func (h *recordingHooks) AfterEpochCatchUp(_ sdk.Context, _ string, policy types.CatchUpPolicy, processed, skipped int64) error {
+	h.policy = policy
+	h.processed = processed
+	h.skipped = skipped
+	h.catchUps++
+	return nil
+}
+
+func (h *recordingHooks) GetModuleName() string {
+	return "recording"
+}
+
+func TestBeginBlockerCatchUp(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	// the chain resumes in the middle of epoch 6
+	blockTime := start.Add(5*time.Hour + 30*time.Minute)
+
+	testCases := []struct {
+		name         string
+		params       types.Params
+		expEnded     []int64
+		expStarted   []int64
+		expCurrent   int64
+		expSkipped   int64
+		expSkipEvent bool
+	}{
+		{
+			name:       "capped to one epoch per block by default",
+			params:     types.DefaultParams(),
+			expEnded:   []int64{1},
+			expStarted: []int64{2},
+			expCurrent: 2,
+		},
+		{
+			name:       "capped treats zero as one",
+			params:     types.NewParams(types.CatchUpPolicyCapped, 0, 0),
+			expEnded:   []int64{1},
+			expStarted: []int64{2},
+			expCurrent: 2,
+		},
+		{
+			name:       "capped to three epochs per block",
+			params:     types.NewParams(types.CatchUpPolicyCapped, 3, 0),
+			expEnded:   []int64{1, 2, 3},
+			expStarted: []int64{2, 3, 4},
+			expCurrent: 4,
+		},
+		{
+			name:       "all missed epochs",
+			params:     types.NewParams(types.CatchUpPolicyAll, 0, 0),
+			expEnded:   []int64{1, 2, 3, 4, 5},
+			expStarted: []int64{2, 3, 4, 5, 6},
+			expCurrent: 6,
+		},
+		{
+			name:         "skip to current epoch",
+			params:       types.NewParams(types.CatchUpPolicySkip, 0, 0),
+			expEnded:     []int64{1},
+			expStarted:   []int64{6},
+			expCurrent:   6,
+			expSkipped:   4,
+			expSkipEvent: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := keepertest.EpochsKeeper(t)
+			hooks := &recordingHooks{}
+			k.SetHooks(hooks)
+			k.SetParams(ctx, tc.params)
+
+			k.SetEpochInfo(ctx, types.EpochInfo{
+				Identifier:            types.HourEpochID,
+				StartTime:             start,
+				Duration:              time.Hour,
+				CurrentEpoch:          1,
+				CurrentEpochStartTime: start,
+				EpochCountingStarted:  true,
+			})
+
+			ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+			k.BeginBlocker(ctx)
+
+			require.Equal(t, tc.expEnded, hooks.ended)
+			require.Equal(t, tc.expStarted, hooks.started)
+
+			info, found := k.GetEpochInfo(ctx, types.HourEpochID)
+			require.True(t, found)
+			require.Equal(t, tc.expCurrent, info.CurrentEpoch)
+			require.Equal(t, start.Add(time.Duration(tc.expCurrent-1)*time.Hour), info.CurrentEpochStartTime)
+			require.Equal(t, int64(100), info.CurrentEpochStartHeight)
+
+			require.Equal(t, 1, hooks.catchUps)
+			require.Equal(t, tc.params.CatchUpPolicy, hooks.policy)
+			require.Equal(t, int64(len(tc.expStarted)), hooks.processed)
+			require.Equal(t, tc.expSkipped, hooks.skipped)
+
+			skipEvent := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeEpochSkipped {
+					skipEvent = true
+				}
+			}
+			require.Equal(t, tc.expSkipEvent, skipEvent)
+		})
+	}
+}
+
+func TestBeginBlockerNoCatchUp(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+	k.SetParams(ctx, types.NewParams(types.CatchUpPolicyAll, 0, 0))
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:            types.HourEpochID,
+		StartTime:             start,
+		Duration:              time.Hour,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: start,
+		EpochCountingStarted:  true,
+	})
+
+	// exactly at the epoch end the epoch is not over yet
+	k.BeginBlocker(ctx.WithBlockTime(start.Add(time.Hour)))
+	require.Empty(t, hooks.ended)
+
+	k.BeginBlocker(ctx.WithBlockTime(start.Add(time.Hour + time.Second)))
+	require.Equal(t, []int64{1}, hooks.ended)
+	require.Zero(t, hooks.catchUps)
+}
+
+func TestBeginBlockerBlockBased(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:     "ten_blocks",
+		StartTime:      start,
+		DurationBlocks: 10,
+	})
+
+	// the first block after the start time starts the epoch
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(start)
+	k.BeginBlocker(ctx)
+	require.Equal(t, []int64{1}, hooks.started)
+
+	// block time is irrelevant for block-based epochs
+	ctx = ctx.WithBlockHeight(14).WithBlockTime(start.Add(time.Hour * 24 * 365))
+	k.BeginBlocker(ctx)
+	require.Empty(t, hooks.ended)
+
+	ctx = ctx.WithBlockHeight(15).WithBlockTime(start.Add(time.Hour * 24 * 365))
+	k.BeginBlocker(ctx)
+	require.Equal(t, []int64{1}, hooks.ended)
+	require.Equal(t, []int64{1, 2}, hooks.started)
+	require.Zero(t, hooks.catchUps)
+	require.Equal(t, types.EpochContext{
+		Identifier:  "ten_blocks",
+		EpochNumber: 1,
+		StartTime:   start,
+		EndTime:     ctx.BlockTime(),
+		StartHeight: 5,
+		EndHeight:   15,
+		Blocks:      10,
+	}, hooks.lastEnded)
+
+	info, found := k.GetEpochInfo(ctx, "ten_blocks")
+	require.True(t, found)
+	require.Equal(t, int64(2), info.CurrentEpoch)
+	require.Equal(t, int64(15), info.CurrentEpochStartHeight)
+	require.Equal(t, ctx.BlockTime(), info.CurrentEpochStartTime)
+
+	res, err := k.CurrentEpoch(ctx, &types.QueryCurrentEpochRequest{Identifier: "ten_blocks"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCurrentEpochResponse{
+		CurrentEpoch:            2,
+		CurrentEpochStartHeight: 15,
+		DurationBlocks:          10,
+	}, res)
+}
+
+func TestBeginBlockerCalendarAligned(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier: "month",
+		StartTime:  time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC),
+		Alignment:  types.CalendarAlignmentMonth,
+	})
+
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC))
+	k.BeginBlocker(ctx)
+	require.Equal(t, []int64{1}, hooks.started)
+
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+	k.BeginBlocker(ctx)
+	require.Empty(t, hooks.ended)
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(time.Date(2024, 2, 1, 0, 0, 5, 0, time.UTC))
+	k.BeginBlocker(ctx)
+	require.Equal(t, []int64{1}, hooks.ended)
+
+	record, found := k.GetEpochRecord(ctx, "month", 1)
+	require.True(t, found)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), record.StartTime)
+	require.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), record.EndTime)
+
+	info, found := k.GetEpochInfo(ctx, "month")
+	require.True(t, found)
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), info.EpochEndTime())
+}
+
+func TestEndBlockerStats(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	hooks := &recordingHooks{}
+	k.SetHooks(hooks)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:     "three_blocks",
+		StartTime:      start,
+		DurationBlocks: 3,
+	})
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:            "paused",
+		StartTime:             start,
+		Duration:              time.Hour,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: start,
+		EpochCountingStarted:  true,
+		Paused:                true,
+	})
+
+	// not started epochs are not tracked
+	k.EndBlocker(ctx.WithBlockHeight(9).WithTxCount(100).WithBlockGasUsed(100))
+
+	for height := int64(10); height <= 13; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(start).WithTxCount(int(height)).WithBlockGasUsed(uint64(height * 1000))
+		k.BeginBlocker(ctx)
+		k.EndBlocker(ctx)
+	}
+
+	// epoch 1 spans blocks 10 to 12 and ends in block 13
+	require.Equal(t, types.EpochStats{TxCount: 33, GasUsed: 33000, BlockCount: 3}, hooks.lastEnded.Stats)
+
+	record, found := k.GetEpochRecord(ctx, "three_blocks", 1)
+	require.True(t, found)
+	require.Equal(t, hooks.lastEnded.Stats, record.Stats)
+
+	res, err := k.EpochStats(ctx, &types.QueryEpochStatsRequest{Identifier: "three_blocks", EpochNumber: 1})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEpochStatsResponse{EpochNumber: 1, Completed: true, Stats: record.Stats}, res)
+
+	res, err = k.EpochStats(ctx, &types.QueryEpochStatsRequest{Identifier: "three_blocks"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryEpochStatsResponse{
+		EpochNumber: 2,
+		Stats:       types.EpochStats{TxCount: 13, GasUsed: 13000, BlockCount: 1},
+	}, res)
+
+	res, err = k.EpochStats(ctx, &types.QueryEpochStatsRequest{Identifier: "paused"})
+	require.NoError(t, err)
+	require.Equal(t, types.EpochStats{}, res.Stats)
+
+	_, err = k.EpochStats(ctx, &types.QueryEpochStatsRequest{Identifier: "three_blocks", EpochNumber: 5})
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,57 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// TrackBlockTime updates the moving average of the block times with the
+// interval between the previous and the current block
+func (k Keeper) TrackBlockTime(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime()
+
+	if bz := store.Get(types.KeyLastBlockTime); bz != nil {
+		interval := blockTime.Sub(time.Unix(0, int64(sdk.BigEndianToUint64(bz))))
+		if interval > 0 {
+			avg := k.GetAverageBlockTime(ctx)
+			if avg == 0 {
+				avg = interval
+			} else {
+				avg += (interval - avg) / types.BlockTimeWindow
+			}
+			store.Set(types.KeyAverageBlockTime, sdk.Uint64ToBigEndian(uint64(avg)))
+		}
+	}
+
+	store.Set(types.KeyLastBlockTime, sdk.Uint64ToBigEndian(uint64(blockTime.UnixNano())))
+}
+
+// GetAverageBlockTime returns the moving average of recent block times, zero
+// if it has not been recorded yet
+func (k Keeper) GetAverageBlockTime(ctx sdk.Context) time.Duration {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyAverageBlockTime)
+	if bz == nil {
+		return 0
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz))
+}
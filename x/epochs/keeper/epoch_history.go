@@ -0,0 +1,137 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// GetEpochRecord returns the record of a completed epoch
+func (k Keeper) GetEpochRecord(ctx sdk.Context, identifier string, epochNumber int64) (types.EpochRecord, bool) {
+	record := types.EpochRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochHistory)
+	bz := store.Get(types.EpochHistoryKey(identifier, epochNumber))
+	if len(bz) == 0 {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetEpochRecord stores the record of a completed epoch
+func (k Keeper) SetEpochRecord(ctx sdk.Context, record types.EpochRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochHistory)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.EpochHistoryKey(record.Identifier, record.EpochNumber), bz)
+}
+
+// PruneEpochHistory deletes the records of the given identifier with an
+// epoch number lower than before. Epochs are numbered from 1, so nothing is
+// deleted if before is not above 1.
+func (k Keeper) PruneEpochHistory(ctx sdk.Context, identifier string, before int64) {
+	// a negative epoch number would turn into a key after every record
+	if before <= 1 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochHistory)
+
+	iterator := store.Iterator(types.EpochHistoryPrefix(identifier), types.EpochHistoryKey(identifier, before))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// DeleteEpochHistory deletes all records of the given identifier
+func (k Keeper) DeleteEpochHistory(ctx sdk.Context, identifier string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochHistory)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.EpochHistoryPrefix(identifier))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// IterateEpochHistory iterates through the records of all identifiers
+func (k Keeper) IterateEpochHistory(ctx sdk.Context, fn func(record types.EpochRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochHistory)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.EpochRecord{}
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if fn(record) {
+			break
+		}
+	}
+}
+
+// AllEpochRecords returns every epoch record in the store
+func (k Keeper) AllEpochRecords(ctx sdk.Context) []types.EpochRecord {
+	records := []types.EpochRecord{}
+	k.IterateEpochHistory(ctx, func(record types.EpochRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// recordEpoch stores the record of the current epoch of the given epoch
+// info, which ends in the current block, and prunes records that fall out of
+// the retention window
+func (k Keeper) recordEpoch(ctx sdk.Context, params types.Params, epochInfo types.EpochInfo, endTime time.Time) types.EpochRecord {
+	record := types.EpochRecord{
+		Identifier:  epochInfo.Identifier,
+		EpochNumber: epochInfo.CurrentEpoch,
+		StartTime:   epochInfo.CurrentEpochStartTime,
+		EndTime:     endTime,
+		StartHeight: epochInfo.CurrentEpochStartHeight,
+		EndHeight:   ctx.BlockHeight(),
+		Stats:       epochInfo.Stats,
+	}
+	k.SetEpochRecord(ctx, record)
+
+	// nothing falls out of the retention window before more epochs have
+	// finished than it holds
+	if before := epochInfo.CurrentEpoch - int64(params.HistoryRetention) + 1; params.HistoryRetention > 0 && before > 1 {
+		k.PruneEpochHistory(ctx, epochInfo.Identifier, before)
+	}
+
+	return record
+}
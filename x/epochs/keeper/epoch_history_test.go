@@ -0,0 +1,116 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestEpochHistory(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	k.SetParams(ctx, types.NewParams(types.CatchUpPolicyCapped, 1, 3))
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.HourEpochID, StartTime: start, Duration: time.Hour})
+	// an identifier sharing the prefix must not show up in the history
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.HourEpochID + "s", StartTime: start, Duration: time.Hour})
+
+	// one block every 10 minutes for five hours
+	for height := int64(1); height <= 31; height++ {
+		blockTime := start.Add(time.Duration(height-1) * time.Minute * 10)
+		k.BeginBlocker(ctx.WithBlockHeight(height).WithBlockTime(blockTime))
+	}
+
+	info, found := k.GetEpochInfo(ctx, types.HourEpochID)
+	require.True(t, found)
+	require.Equal(t, int64(5), info.CurrentEpoch)
+
+	// epoch 1 fell out of the retention window
+	_, found = k.GetEpochRecord(ctx, types.HourEpochID, 1)
+	require.False(t, found)
+
+	res, err := k.EpochHistory(ctx, &types.QueryEpochHistoryRequest{Identifier: types.HourEpochID})
+	require.NoError(t, err)
+	require.Equal(t, []types.EpochRecord{
+		{
+			Identifier:  types.HourEpochID,
+			EpochNumber: 2,
+			StartTime:   start.Add(time.Hour),
+			EndTime:     start.Add(time.Hour * 2),
+			StartHeight: 8,
+			EndHeight:   14,
+		},
+		{
+			Identifier:  types.HourEpochID,
+			EpochNumber: 3,
+			StartTime:   start.Add(time.Hour * 2),
+			EndTime:     start.Add(time.Hour * 3),
+			StartHeight: 14,
+			EndHeight:   20,
+		},
+		{
+			Identifier:  types.HourEpochID,
+			EpochNumber: 4,
+			StartTime:   start.Add(time.Hour * 3),
+			EndTime:     start.Add(time.Hour * 4),
+			StartHeight: 20,
+			EndHeight:   26,
+		},
+	}, res.Records)
+
+	res, err = k.EpochHistory(ctx, &types.QueryEpochHistoryRequest{
+		Identifier: types.HourEpochID,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, int64(4), res.Records[0].EpochNumber)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	k.DeleteEpochHistory(ctx, types.HourEpochID)
+	require.Len(t, k.AllEpochRecords(ctx), 3)
+}
+
+func TestEpochHistoryWithinRetention(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	k.SetParams(ctx, types.NewParams(types.CatchUpPolicyCapped, 1, 10))
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.HourEpochID, StartTime: start, Duration: time.Hour})
+
+	// one block every 10 minutes for five hours
+	for height := int64(1); height <= 31; height++ {
+		blockTime := start.Add(time.Duration(height-1) * time.Minute * 10)
+		k.BeginBlocker(ctx.WithBlockHeight(height).WithBlockTime(blockTime))
+	}
+
+	// fewer epochs finished than the retention window holds, so every record
+	// is kept
+	for epochNumber := int64(1); epochNumber <= 4; epochNumber++ {
+		_, found := k.GetEpochRecord(ctx, types.HourEpochID, epochNumber)
+		require.True(t, found, "epoch %d", epochNumber)
+	}
+
+	// pruning before the first epoch deletes nothing
+	k.PruneEpochHistory(ctx, types.HourEpochID, -5)
+	require.Len(t, k.AllEpochRecords(ctx), 4)
+}
@@ -17,42 +17,270 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Galactica-corp/galactica/x/epochs/types"
 )
 
-var _ types.EpochHooks = MultiEpochHooks{}
+var (
+	_ types.EpochHooksV2         = MultiEpochHooks{}
+	_ types.EpochCatchUpHooks    = MultiEpochHooks{}
+	_ types.EpochDependencyHooks = MultiEpochHooks{}
+	_ types.EpochHooksV2         = CriticalEpochHooks{}
+	_ types.EpochCatchUpHooks    = CriticalEpochHooks{}
+	_ types.EpochDependencyHooks = CriticalEpochHooks{}
+	_ types.EpochHooksV2         = EpochHooksAdapter{}
+	_ types.EpochCatchUpHooks    = EpochHooksAdapter{}
+	_ types.EpochDependencyHooks = EpochHooksAdapter{}
+)
 
-// combine multiple epoch hooks, all hook functions are run in array sequence
-type MultiEpochHooks []types.EpochHooks
+// combine multiple epoch hooks, all hook functions are run in array sequence.
+// Each hook runs in a cached context: if it returns an error or panics, its
+// state changes are discarded, an epoch_hook_failed event is emitted and the
+// remaining hooks are still executed. Hooks wrapped with NewCriticalEpochHooks
+// are not isolated and their failure halts the chain.
+type MultiEpochHooks []types.EpochHooksV2
 
-func NewMultiEpochHooks(hooks ...types.EpochHooks) MultiEpochHooks {
+func NewMultiEpochHooks(hooks ...types.EpochHooksV2) MultiEpochHooks {
 	return hooks
 }
 
-// AfterEpochEnd is called when epoch is going to be ended, epochNumber is the
-// number of epoch that is ending
-func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+// EpochHooksAdapter registers EpochHooks, which only receive the epoch
+// identifier and number, as EpochHooksV2
+type EpochHooksAdapter struct {
+	hooks types.EpochHooks
+}
+
+// NewEpochHooksAdapter wraps the given EpochHooks
+func NewEpochHooksAdapter(hooks types.EpochHooks) EpochHooksAdapter {
+	return EpochHooksAdapter{hooks}
+}
+
+// AfterEpochEnd calls the wrapped hooks with the number of the ended epoch
+func (a EpochHooksAdapter) AfterEpochEnd(ctx sdk.Context, epoch types.EpochContext) error {
+	return a.hooks.AfterEpochEnd(ctx, epoch.Identifier, epoch.EpochNumber)
+}
+
+// BeforeEpochStart calls the wrapped hooks with the number of the starting
+// epoch
+func (a EpochHooksAdapter) BeforeEpochStart(ctx sdk.Context, epoch types.EpochContext) error {
+	return a.hooks.BeforeEpochStart(ctx, epoch.Identifier, epoch.EpochNumber)
+}
+
+// GetModuleName implements EpochHooksV2
+func (a EpochHooksAdapter) GetModuleName() string {
+	return a.hooks.GetModuleName()
+}
+
+// AfterEpochCatchUp forwards to the wrapped hooks if they implement
+// EpochCatchUpHooks
+func (a EpochHooksAdapter) AfterEpochCatchUp(ctx sdk.Context, epochIdentifier string, policy types.CatchUpPolicy, processedEpochs, skippedEpochs int64) error {
+	if h, ok := a.hooks.(types.EpochCatchUpHooks); ok {
+		return h.AfterEpochCatchUp(ctx, epochIdentifier, policy, processedEpochs, skippedEpochs)
+	}
+	return nil
+}
+
+// EpochIdentifiersInUse forwards to the wrapped hooks if they implement
+// EpochDependencyHooks
+func (a EpochHooksAdapter) EpochIdentifiersInUse(ctx sdk.Context) []string {
+	if h, ok := a.hooks.(types.EpochDependencyHooks); ok {
+		return h.EpochIdentifiersInUse(ctx)
+	}
+	return nil
+}
+
+// CriticalEpochHooks marks epoch hooks whose failure must halt the chain
+type CriticalEpochHooks struct {
+	types.EpochHooksV2
+}
+
+// NewCriticalEpochHooks registers the given hooks as critical
+func NewCriticalEpochHooks(hooks types.EpochHooksV2) CriticalEpochHooks {
+	return CriticalEpochHooks{hooks}
+}
+
+// AfterEpochCatchUp forwards to the wrapped hooks if they implement
+// EpochCatchUpHooks
+func (ch CriticalEpochHooks) AfterEpochCatchUp(ctx sdk.Context, epochIdentifier string, policy types.CatchUpPolicy, processedEpochs, skippedEpochs int64) error {
+	if h, ok := ch.EpochHooksV2.(types.EpochCatchUpHooks); ok {
+		return h.AfterEpochCatchUp(ctx, epochIdentifier, policy, processedEpochs, skippedEpochs)
+	}
+	return nil
+}
+
+// EpochIdentifiersInUse forwards to the wrapped hooks if they implement
+// EpochDependencyHooks
+func (ch CriticalEpochHooks) EpochIdentifiersInUse(ctx sdk.Context) []string {
+	if h, ok := ch.EpochHooksV2.(types.EpochDependencyHooks); ok {
+		return h.EpochIdentifiersInUse(ctx)
+	}
+	return nil
+}
+
+// AfterEpochEnd is called when epoch has ended, with the context of the ended
+// epoch
+func (mh MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epoch types.EpochContext) error {
+	for i := range mh {
+		if err := runHook(ctx, mh[i], types.HookAfterEpochEnd, epoch.Identifier, epoch.EpochNumber, func(ctx sdk.Context) error {
+			return mh[i].AfterEpochEnd(ctx, epoch)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BeforeEpochStart is called when epoch is going to be started, with the
+// context of the starting epoch
+func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epoch types.EpochContext) error {
 	for i := range mh {
-		mh[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		if err := runHook(ctx, mh[i], types.HookBeforeEpochStart, epoch.Identifier, epoch.EpochNumber, func(ctx sdk.Context) error {
+			return mh[i].BeforeEpochStart(ctx, epoch)
+		}); err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
-// BeforeEpochStart is called when epoch is going to be started, epochNumber is
-// the number of epoch that is starting
-func (mh MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+// AfterEpochCatchUp is called after missed epochs were processed, for every
+// hook that implements EpochCatchUpHooks
+func (mh MultiEpochHooks) AfterEpochCatchUp(ctx sdk.Context, epochIdentifier string, policy types.CatchUpPolicy, processedEpochs, skippedEpochs int64) error {
 	for i := range mh {
-		mh[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+		h, ok := mh[i].(types.EpochCatchUpHooks)
+		if !ok {
+			continue
+		}
+		if err := runHook(ctx, mh[i], types.HookAfterEpochCatchUp, epochIdentifier, 0, func(ctx sdk.Context) error {
+			return h.AfterEpochCatchUp(ctx, epochIdentifier, policy, processedEpochs, skippedEpochs)
+		}); err != nil {
+			return err
+		}
 	}
+	return nil
+}
+
+// EpochIdentifiersInUse returns the epoch identifiers of all hooks that
+// implement EpochDependencyHooks
+func (mh MultiEpochHooks) EpochIdentifiersInUse(ctx sdk.Context) []string {
+	var identifiers []string
+	for i := range mh {
+		if h, ok := mh[i].(types.EpochDependencyHooks); ok {
+			identifiers = append(identifiers, h.EpochIdentifiersInUse(ctx)...)
+		}
+	}
+	return identifiers
+}
+
+// GetModuleName implements EpochHooksV2
+func (MultiEpochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// runHook executes fn for the given hooks. Critical hooks run on the passed
+// context and their error is returned. All other hooks run on a cached
+// context that is only written on success, failures are logged and reported
+// through an event.
+func runHook(ctx sdk.Context, hooks types.EpochHooksV2, hook, epochIdentifier string, epochNumber int64, fn func(sdk.Context) error) error {
+	if _, ok := hooks.(CriticalEpochHooks); ok {
+		return fn(ctx)
+	}
+
+	err := applyIsolated(ctx, fn)
+	if err == nil {
+		return nil
+	}
+
+	ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName)).Error(
+		"epoch hook failed",
+		"hook_module", hooks.GetModuleName(),
+		"hook", hook,
+		"identifier", epochIdentifier,
+		"error", err.Error(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochHookFailed,
+			sdk.NewAttribute(types.AttributeHookModule, hooks.GetModuleName()),
+			sdk.NewAttribute(types.AttributeHook, hook),
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epochIdentifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeError, err.Error()),
+		),
+	)
+
+	return nil
+}
+
+// applyIsolated runs fn on a cached context and writes its state changes and
+// events only if fn neither returns an error nor panics.
+func applyIsolated(ctx sdk.Context, fn func(sdk.Context) error) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	if err := fn(cacheCtx); err != nil {
+		return err
+	}
+
+	write()
+	return nil
 }
 
 // AfterEpochEnd executes the indicated hook after epochs ends
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.AfterEpochEnd(ctx, identifier, epochNumber)
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epoch types.EpochContext) {
+	if k.hooks == nil {
+		return
+	}
+	if err := k.hooks.AfterEpochEnd(ctx, epoch); err != nil {
+		panic(fmt.Errorf("critical epoch hook %s failed: %w", types.HookAfterEpochEnd, err))
+	}
+}
+
+// BeforeEpochStart runs the scheduled executions of the starting epoch and
+// executes the indicated hook before the epochs
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epoch types.EpochContext) {
+	k.executeScheduledExecutions(ctx, epoch.Identifier, epoch.EpochNumber)
+
+	if k.hooks == nil {
+		return
+	}
+	if err := k.hooks.BeforeEpochStart(ctx, epoch); err != nil {
+		panic(fmt.Errorf("critical epoch hook %s failed: %w", types.HookBeforeEpochStart, err))
+	}
+}
+
+// AfterEpochCatchUp executes the indicated hook after missed epochs were processed
+func (k Keeper) AfterEpochCatchUp(ctx sdk.Context, identifier string, policy types.CatchUpPolicy, processedEpochs, skippedEpochs int64) {
+	h, ok := k.hooks.(types.EpochCatchUpHooks)
+	if !ok {
+		return
+	}
+	if err := h.AfterEpochCatchUp(ctx, identifier, policy, processedEpochs, skippedEpochs); err != nil {
+		panic(fmt.Errorf("critical epoch hook %s failed: %w", types.HookAfterEpochCatchUp, err))
+	}
 }
 
-// BeforeEpochStart executes the indicated hook before the epochs
-func (k Keeper) BeforeEpochStart(ctx sdk.Context, identifier string, epochNumber int64) {
-	k.hooks.BeforeEpochStart(ctx, identifier, epochNumber)
+// IsEpochInUse returns true if one of the epoch hooks depends on the given
+// epoch identifier
+func (k Keeper) IsEpochInUse(ctx sdk.Context, identifier string) bool {
+	h, ok := k.hooks.(types.EpochDependencyHooks)
+	if !ok {
+		return false
+	}
+	for _, id := range h.EpochIdentifiersInUse(ctx) {
+		if id == identifier {
+			return true
+		}
+	}
+	return false
 }
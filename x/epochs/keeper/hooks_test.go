@@ -0,0 +1,115 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+type funcHooks struct {
+	name string
+	fn   func(ctx sdk.Context) error
+}
+
+func (h funcHooks) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) error {
+	return h.fn(ctx)
+}
+
+func (h funcHooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) error {
+	return nil
+}
+
+func (h funcHooks) GetModuleName() string {
+	return h.name
+}
+
+func TestMultiEpochHooksIsolation(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+
+	// every hook stores an epoch info named after its module before it fails
+	writeAnd := func(name string, fail func()) funcHooks {
+		return funcHooks{name: name, fn: func(ctx sdk.Context) error {
+			k.SetEpochInfo(ctx, types.EpochInfo{Identifier: name, Duration: time.Hour})
+			if fail != nil {
+				fail()
+			}
+			return nil
+		}}
+	}
+
+	failing := funcHooks{name: "failing", fn: func(ctx sdk.Context) error {
+		k.SetEpochInfo(ctx, types.EpochInfo{Identifier: "failing", Duration: time.Hour})
+		return errors.New("boom")
+	}}
+	panicking := writeAnd("panicking", func() { panic("kaboom") })
+	healthy := writeAnd("healthy", nil)
+
+	hooks := keeper.NewMultiEpochHooks(
+		keeper.NewEpochHooksAdapter(failing),
+		keeper.NewEpochHooksAdapter(panicking),
+		keeper.NewEpochHooksAdapter(healthy),
+	)
+	k.SetHooks(hooks)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { k.AfterEpochEnd(ctx, types.EpochContext{Identifier: types.DayEpochID, EpochNumber: 1}) })
+
+	_, found := k.GetEpochInfo(ctx, "failing")
+	require.False(t, found)
+	_, found = k.GetEpochInfo(ctx, "panicking")
+	require.False(t, found)
+	_, found = k.GetEpochInfo(ctx, "healthy")
+	require.True(t, found)
+
+	var failed []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeEpochHookFailed {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeHookModule {
+				failed = append(failed, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{"failing", "panicking"}, failed)
+}
+
+func TestMultiEpochHooksCritical(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+
+	failing := funcHooks{name: "failing", fn: func(sdk.Context) error {
+		return errors.New("boom")
+	}}
+	panicking := funcHooks{name: "panicking", fn: func(sdk.Context) error {
+		panic("kaboom")
+	}}
+
+	k.SetHooks(keeper.NewMultiEpochHooks(keeper.NewCriticalEpochHooks(keeper.NewEpochHooksAdapter(failing))))
+	require.Panics(t, func() { k.AfterEpochEnd(ctx, types.EpochContext{Identifier: types.DayEpochID, EpochNumber: 1}) })
+
+	k2, ctx2 := keepertest.EpochsKeeper(t)
+	k2.SetHooks(keeper.NewMultiEpochHooks(keeper.NewCriticalEpochHooks(keeper.NewEpochHooksAdapter(panicking))))
+	require.PanicsWithValue(t, "kaboom", func() { k2.AfterEpochEnd(ctx2, types.EpochContext{Identifier: types.DayEpochID, EpochNumber: 1}) })
+}
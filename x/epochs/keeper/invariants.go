@@ -0,0 +1,130 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// RegisterInvariants registers the epochs module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "epoch-start", EpochStartInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "epoch-history", EpochHistoryInvariant(k))
+}
+
+// AllInvariants runs all invariants of the epochs module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := EpochStartInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return EpochHistoryInvariant(k)(ctx)
+	}
+}
+
+// EpochStartInvariant checks that every stored epoch info is valid and that
+// the current epoch of a started epoch is numbered from 1 and did not start
+// after the current block
+func EpochStartInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+			if err := epochInfo.Validate(); err != nil {
+				broken++
+				msg += fmt.Sprintf("\tinvalid epoch info %s: %s\n", epochInfo.Identifier, err)
+				return false
+			}
+			if !epochInfo.EpochCountingStarted {
+				return false
+			}
+			if epochInfo.CurrentEpoch < 1 {
+				broken++
+				msg += fmt.Sprintf("\tepoch %s started with current epoch %d\n", epochInfo.Identifier, epochInfo.CurrentEpoch)
+			}
+			if epochInfo.CurrentEpochStartTime.After(ctx.BlockTime()) {
+				broken++
+				msg += fmt.Sprintf("\tepoch %s current epoch start time %s is after the block time %s\n",
+					epochInfo.Identifier, epochInfo.CurrentEpochStartTime, ctx.BlockTime())
+			}
+			if epochInfo.CurrentEpochStartHeight > ctx.BlockHeight() {
+				broken++
+				msg += fmt.Sprintf("\tepoch %s current epoch start height %d is after the block height %d\n",
+					epochInfo.Identifier, epochInfo.CurrentEpochStartHeight, ctx.BlockHeight())
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "epoch-start",
+			fmt.Sprintf("found %d invalid epochs\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// EpochHistoryInvariant checks that every epoch record is valid and belongs to
+// a completed epoch of an existing identifier
+func EpochHistoryInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		epochs := make(map[string]types.EpochInfo)
+		k.IterateEpochInfo(ctx, func(_ int64, epochInfo types.EpochInfo) (stop bool) {
+			epochs[epochInfo.Identifier] = epochInfo
+			return false
+		})
+
+		k.IterateEpochHistory(ctx, func(record types.EpochRecord) (stop bool) {
+			if err := record.Validate(); err != nil {
+				broken++
+				msg += fmt.Sprintf("\tinvalid epoch record %s %d: %s\n", record.Identifier, record.EpochNumber, err)
+				return false
+			}
+
+			epochInfo, found := epochs[record.Identifier]
+			switch {
+			case !found:
+				broken++
+				msg += fmt.Sprintf("\tepoch record %s %d has no epoch info\n", record.Identifier, record.EpochNumber)
+			case record.EpochNumber >= epochInfo.CurrentEpoch:
+				broken++
+				msg += fmt.Sprintf("\tepoch record %s %d is not before the current epoch %d\n",
+					record.Identifier, record.EpochNumber, epochInfo.CurrentEpoch)
+			case record.EndHeight > epochInfo.CurrentEpochStartHeight:
+				broken++
+				msg += fmt.Sprintf("\tepoch record %s %d ends at height %d after the current epoch start height %d\n",
+					record.Identifier, record.EpochNumber, record.EndHeight, epochInfo.CurrentEpochStartHeight)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "epoch-history",
+			fmt.Sprintf("found %d invalid epoch records\n%s", broken, msg),
+		), broken != 0
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestEpochStartInvariant(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		epoch     types.EpochInfo
+		expBroken bool
+	}{
+		{
+			name:  "not started",
+			epoch: types.EpochInfo{Identifier: types.DayEpochID, StartTime: start.Add(time.Hour), Duration: time.Hour},
+		},
+		{
+			name: "started",
+			epoch: types.EpochInfo{
+				Identifier:              types.DayEpochID,
+				Duration:                time.Hour,
+				CurrentEpoch:            1,
+				CurrentEpochStartTime:   start,
+				CurrentEpochStartHeight: 10,
+				EpochCountingStarted:    true,
+			},
+		},
+		{
+			name:      "invalid epoch info",
+			epoch:     types.EpochInfo{Identifier: types.DayEpochID},
+			expBroken: true,
+		},
+		{
+			name: "started without current epoch",
+			epoch: types.EpochInfo{
+				Identifier:            types.DayEpochID,
+				Duration:              time.Hour,
+				CurrentEpochStartTime: start,
+				EpochCountingStarted:  true,
+			},
+			expBroken: true,
+		},
+		{
+			name: "start time after block time",
+			epoch: types.EpochInfo{
+				Identifier:            types.DayEpochID,
+				Duration:              time.Hour,
+				CurrentEpoch:          1,
+				CurrentEpochStartTime: start.Add(time.Second),
+				EpochCountingStarted:  true,
+			},
+			expBroken: true,
+		},
+		{
+			name: "start height after block height",
+			epoch: types.EpochInfo{
+				Identifier:              types.DayEpochID,
+				Duration:                time.Hour,
+				CurrentEpoch:            1,
+				CurrentEpochStartTime:   start,
+				CurrentEpochStartHeight: 11,
+				EpochCountingStarted:    true,
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := keepertest.EpochsKeeper(t)
+			ctx = ctx.WithBlockTime(start).WithBlockHeight(10)
+			k.SetEpochInfo(ctx, tc.epoch)
+
+			msg, broken := keeper.EpochStartInvariant(k)(ctx)
+			require.Equal(t, tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func TestEpochHistoryInvariant(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start.Add(time.Hour * 3)).WithBlockHeight(30)
+
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:              types.HourEpochID,
+		Duration:                time.Hour,
+		CurrentEpoch:            3,
+		CurrentEpochStartTime:   start.Add(time.Hour * 2),
+		CurrentEpochStartHeight: 20,
+		EpochCountingStarted:    true,
+	})
+	record := types.EpochRecord{
+		Identifier:  types.HourEpochID,
+		EpochNumber: 2,
+		StartTime:   start.Add(time.Hour),
+		EndTime:     start.Add(time.Hour * 2),
+		StartHeight: 10,
+		EndHeight:   20,
+	}
+	k.SetEpochRecord(ctx, record)
+
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+
+	// records of the current epoch do not exist yet
+	current := record
+	current.EpochNumber = 3
+	k.SetEpochRecord(ctx, current)
+	_, broken = keeper.EpochHistoryInvariant(k)(ctx)
+	require.True(t, broken)
+
+	// records of deleted identifiers are removed with the epoch info
+	k.DeleteEpochInfo(ctx, types.HourEpochID)
+	k.SetEpochRecord(ctx, record)
+	_, broken = keeper.EpochHistoryInvariant(k)(ctx)
+	require.True(t, broken)
+}
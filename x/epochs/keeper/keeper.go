@@ -21,6 +21,7 @@ import (
 
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -29,10 +30,13 @@ import (
 
 type (
 	Keeper struct {
-		cdc      codec.BinaryCodec
+		cdc      codec.Codec
 		storeKey storetypes.StoreKey
 		memKey   storetypes.StoreKey
-		hooks    types.EpochHooks
+		hooks    types.EpochHooksV2
+
+		// router executes the messages of scheduled executions
+		router baseapp.MessageRouter
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -41,10 +45,11 @@ type (
 )
 
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	storeKey,
 	memKey storetypes.StoreKey,
 	authority string,
+	router baseapp.MessageRouter,
 ) *Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -55,6 +60,7 @@ func NewKeeper(
 		storeKey:  storeKey,
 		memKey:    memKey,
 		authority: authority,
+		router:    router,
 	}
 }
 
@@ -69,7 +75,7 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // SetHooks set the epoch hooks
-func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
+func (k *Keeper) SetHooks(eh types.EpochHooksV2) *Keeper {
 	if k.hooks != nil {
 		panic("cannot set epochs hooks twice")
 	}
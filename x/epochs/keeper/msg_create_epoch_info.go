@@ -0,0 +1,64 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func (k msgServer) CreateEpochInfo(goCtx context.Context, req *types.MsgCreateEpochInfo) (*types.MsgCreateEpochInfoResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); found {
+		return nil, errorsmod.Wrapf(types.ErrEpochInfoExists, "identifier %s", req.Identifier)
+	}
+
+	epoch := req.EpochInfo()
+	if err := epoch.Validate(); err != nil {
+		return nil, err
+	}
+
+	// same defaults as InitGenesis
+	if epoch.StartTime.IsZero() {
+		epoch.StartTime = ctx.BlockTime()
+	}
+	epoch.CurrentEpochStartHeight = ctx.BlockHeight()
+
+	k.SetEpochInfo(ctx, epoch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateEpochInfo,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, epoch.Identifier),
+			sdk.NewAttribute(types.AttributeEpochStartTime, strconv.FormatInt(epoch.StartTime.Unix(), 10)),
+			sdk.NewAttribute(types.AttributeEpochDuration, epoch.Duration.String()),
+			sdk.NewAttribute(types.AttributeEpochDurationBlocks, strconv.FormatInt(epoch.DurationBlocks, 10)),
+			sdk.NewAttribute(types.AttributeEpochAlignment, epoch.Alignment.String()),
+		),
+	)
+
+	return &types.MsgCreateEpochInfoResponse{}, nil
+}
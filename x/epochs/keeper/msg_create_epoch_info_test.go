@@ -0,0 +1,118 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestMsgCreateEpochInfo(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(10)
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.DayEpochID, Duration: time.Hour * 24})
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgCreateEpochInfo
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgCreateEpochInfo{
+				Authority:  "invalid",
+				Identifier: types.WeekEpochID,
+				Duration:   time.Hour * 24 * 7,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "already exists",
+			input: &types.MsgCreateEpochInfo{
+				Authority:  k.GetAuthority(),
+				Identifier: types.DayEpochID,
+				Duration:   time.Hour * 24,
+			},
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+		{
+			name: "zero duration",
+			input: &types.MsgCreateEpochInfo{
+				Authority:  k.GetAuthority(),
+				Identifier: types.WeekEpochID,
+			},
+			expErr:    true,
+			expErrMsg: "epoch duration cannot be 0",
+		},
+		{
+			name: "duration and duration blocks",
+			input: &types.MsgCreateEpochInfo{
+				Authority:      k.GetAuthority(),
+				Identifier:     types.WeekEpochID,
+				Duration:       time.Hour * 24 * 7,
+				DurationBlocks: 100,
+			},
+			expErr:    true,
+			expErrMsg: "cannot both be set",
+		},
+		{
+			name: "block based",
+			input: &types.MsgCreateEpochInfo{
+				Authority:      k.GetAuthority(),
+				Identifier:     "hundred_blocks",
+				DurationBlocks: 100,
+			},
+			expErr: false,
+		},
+		{
+			name: "all good",
+			input: &types.MsgCreateEpochInfo{
+				Authority:  k.GetAuthority(),
+				Identifier: types.WeekEpochID,
+				Duration:   time.Hour * 24 * 7,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.CreateEpochInfo(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	epoch, found := k.GetEpochInfo(ctx, types.WeekEpochID)
+	require.True(t, found)
+	require.Equal(t, blockTime, epoch.StartTime)
+	require.Equal(t, int64(10), epoch.CurrentEpochStartHeight)
+	require.False(t, epoch.EpochCountingStarted)
+	require.NotEmpty(t, sdk.UnwrapSDKContext(ctx).EventManager().Events())
+}
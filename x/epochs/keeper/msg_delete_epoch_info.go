@@ -0,0 +1,55 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func (k msgServer) DeleteEpochInfo(goCtx context.Context, req *types.MsgDeleteEpochInfo) (*types.MsgDeleteEpochInfoResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetEpochInfo(ctx, req.Identifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochInfoNotFound, "identifier %s", req.Identifier)
+	}
+	if k.IsEpochInUse(ctx, req.Identifier) {
+		return nil, errorsmod.Wrapf(types.ErrEpochInUse, "identifier %s is used by the epoch hooks", req.Identifier)
+	}
+
+	// a re-created identifier restarts counting at epoch 1, so the records and
+	// scheduled executions of the deleted one are removed as well
+	k.Keeper.DeleteEpochInfo(ctx, req.Identifier)
+	k.DeleteEpochHistory(ctx, req.Identifier)
+	k.DeleteScheduledExecutions(ctx, req.Identifier)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDeleteEpochInfo,
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+		),
+	)
+
+	return &types.MsgDeleteEpochInfoResponse{}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestMsgDeleteEpochInfo(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.DayEpochID, Duration: time.Hour * 24})
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgDeleteEpochInfo
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgDeleteEpochInfo{
+				Authority:  "invalid",
+				Identifier: types.DayEpochID,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "not found",
+			input: &types.MsgDeleteEpochInfo{
+				Authority:  k.GetAuthority(),
+				Identifier: types.WeekEpochID,
+			},
+			expErr:    true,
+			expErrMsg: "not found",
+		},
+		{
+			name: "all good",
+			input: &types.MsgDeleteEpochInfo{
+				Authority:  k.GetAuthority(),
+				Identifier: types.DayEpochID,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.DeleteEpochInfo(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	_, found := k.GetEpochInfo(ctx, types.DayEpochID)
+	require.False(t, found)
+}
+
+// dependentHooks depend on the given epoch identifiers
+type dependentHooks struct {
+	funcHooks
+	identifiers []string
+}
+
+func (h dependentHooks) EpochIdentifiersInUse(sdk.Context) []string {
+	return h.identifiers
+}
+
+func TestMsgDeleteEpochInfoInUse(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	k.SetHooks(keeper.NewMultiEpochHooks(
+		keeper.NewEpochHooksAdapter(funcHooks{name: "independent"}),
+		keeper.NewCriticalEpochHooks(keeper.NewEpochHooksAdapter(dependentHooks{
+			funcHooks:   funcHooks{name: "dependent"},
+			identifiers: []string{types.WeekEpochID},
+		})),
+	))
+	ms := keeper.NewMsgServerImpl(k)
+
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.DayEpochID, Duration: time.Hour * 24})
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.WeekEpochID, Duration: time.Hour * 24 * 7})
+	require.False(t, k.IsEpochInUse(ctx, types.DayEpochID))
+	require.True(t, k.IsEpochInUse(ctx, types.WeekEpochID))
+
+	_, err := ms.DeleteEpochInfo(ctx, &types.MsgDeleteEpochInfo{Authority: k.GetAuthority(), Identifier: types.WeekEpochID})
+	require.ErrorIs(t, err, types.ErrEpochInUse)
+	_, found := k.GetEpochInfo(ctx, types.WeekEpochID)
+	require.True(t, found)
+
+	_, err = ms.DeleteEpochInfo(ctx, &types.MsgDeleteEpochInfo{Authority: k.GetAuthority(), Identifier: types.DayEpochID})
+	require.NoError(t, err)
+	_, found = k.GetEpochInfo(ctx, types.DayEpochID)
+	require.False(t, found)
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestMsgPauseEpoch(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	k.SetEpochInfo(ctx, types.EpochInfo{
+		Identifier:            types.HourEpochID,
+		StartTime:             start,
+		Duration:              time.Hour,
+		CurrentEpoch:          1,
+		CurrentEpochStartTime: start,
+		EpochCountingStarted:  true,
+	})
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgPauseEpoch
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgPauseEpoch{
+				Authority:  "invalid",
+				Identifier: types.HourEpochID,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "not found",
+			input: &types.MsgPauseEpoch{
+				Authority:  k.GetAuthority(),
+				Identifier: types.WeekEpochID,
+			},
+			expErr:    true,
+			expErrMsg: "not found",
+		},
+		{
+			name: "all good",
+			input: &types.MsgPauseEpoch{
+				Authority:  k.GetAuthority(),
+				Identifier: types.HourEpochID,
+			},
+			expErr: false,
+		},
+		{
+			name: "already paused",
+			input: &types.MsgPauseEpoch{
+				Authority:  k.GetAuthority(),
+				Identifier: types.HourEpochID,
+			},
+			expErr:    true,
+			expErrMsg: "epoch is paused",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.PauseEpoch(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// a paused epoch is not advanced by the BeginBlocker
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(start.Add(time.Hour * 5))
+	k.BeginBlocker(sdkCtx)
+
+	epoch, found := k.GetEpochInfo(sdkCtx, types.HourEpochID)
+	require.True(t, found)
+	require.True(t, epoch.Paused)
+	require.Equal(t, int64(1), epoch.CurrentEpoch)
+}
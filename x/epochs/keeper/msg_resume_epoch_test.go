@@ -0,0 +1,124 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestMsgResumeEpoch(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	resumeTime := start.Add(time.Hour*5 + time.Minute*30)
+
+	testCases := []struct {
+		name       string
+		input      *types.MsgResumeEpoch
+		paused     bool
+		expErr     bool
+		expErrMsg  string
+		expCurrent int64
+		expStart   time.Time
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgResumeEpoch{
+				Authority:  "invalid",
+				Identifier: types.HourEpochID,
+			},
+			paused:    true,
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "not found",
+			input: &types.MsgResumeEpoch{
+				Identifier: types.WeekEpochID,
+			},
+			paused:    true,
+			expErr:    true,
+			expErrMsg: "not found",
+		},
+		{
+			name: "not paused",
+			input: &types.MsgResumeEpoch{
+				Identifier: types.HourEpochID,
+			},
+			paused:    false,
+			expErr:    true,
+			expErrMsg: "epoch is not paused",
+		},
+		{
+			name: "continue original schedule",
+			input: &types.MsgResumeEpoch{
+				Identifier: types.HourEpochID,
+			},
+			paused: true,
+			// the default catch up policy ends one missed epoch per block
+			expCurrent: 2,
+			expStart:   start.Add(time.Hour),
+		},
+		{
+			name: "reanchor",
+			input: &types.MsgResumeEpoch{
+				Identifier: types.HourEpochID,
+				Reanchor:   true,
+			},
+			paused:     true,
+			expCurrent: 1,
+			expStart:   resumeTime,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ms, goCtx := setupMsgServer(t)
+			ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(resumeTime).WithBlockHeight(50)
+			k.SetEpochInfo(ctx, types.EpochInfo{
+				Identifier:            types.HourEpochID,
+				StartTime:             start,
+				Duration:              time.Hour,
+				CurrentEpoch:          1,
+				CurrentEpochStartTime: start,
+				EpochCountingStarted:  true,
+				Paused:                tc.paused,
+			})
+			if tc.input.Authority == "" {
+				tc.input.Authority = k.GetAuthority()
+			}
+
+			_, err := ms.ResumeEpoch(ctx, tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			k.BeginBlocker(ctx)
+
+			epoch, found := k.GetEpochInfo(ctx, types.HourEpochID)
+			require.True(t, found)
+			require.False(t, epoch.Paused)
+			require.Equal(t, tc.expCurrent, epoch.CurrentEpoch)
+			require.Equal(t, tc.expStart, epoch.CurrentEpochStartTime)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func (k msgServer) ScheduleExecution(goCtx context.Context, req *types.MsgScheduleExecution) (*types.MsgScheduleExecutionResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidScheduledExecution, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.Keeper.ScheduleExecution(ctx, req.Identifier, req.EpochNumber, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleExecution,
+			sdk.NewAttribute(types.AttributeScheduledExecutionID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeEpochIdentifier, req.Identifier),
+			sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(req.EpochNumber, 10)),
+		),
+	)
+
+	return &types.MsgScheduleExecutionResponse{Id: id}, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestMsgScheduleExecution(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.DayEpochID, Duration: time.Hour * 24, CurrentEpoch: 3, EpochCountingStarted: true})
+
+	updateDuration := &types.MsgUpdateEpochDuration{Authority: k.GetAuthority(), Identifier: types.DayEpochID, Duration: time.Hour}
+
+	testCases := []struct {
+		name        string
+		identifier  string
+		epochNumber int64
+		msgs        []sdk.Msg
+		authority   string
+		expErrMsg   string
+	}{
+		{
+			name:        "invalid authority",
+			identifier:  types.DayEpochID,
+			epochNumber: 4,
+			msgs:        []sdk.Msg{updateDuration},
+			authority:   "invalid",
+			expErrMsg:   "invalid authority",
+		},
+		{
+			name:        "not found",
+			identifier:  types.WeekEpochID,
+			epochNumber: 4,
+			msgs:        []sdk.Msg{updateDuration},
+			expErrMsg:   "not found",
+		},
+		{
+			name:        "current epoch",
+			identifier:  types.DayEpochID,
+			epochNumber: 3,
+			msgs:        []sdk.Msg{updateDuration},
+			expErrMsg:   "is not after the current epoch",
+		},
+		{
+			name:        "not signed by the authority",
+			identifier:  types.DayEpochID,
+			epochNumber: 4,
+			msgs: []sdk.Msg{
+				&types.MsgUpdateEpochDuration{Authority: sdk.AccAddress("other").String(), Identifier: types.DayEpochID, Duration: time.Hour},
+			},
+			expErrMsg: "must be signed by the authority",
+		},
+		{
+			name:        "all good",
+			identifier:  types.DayEpochID,
+			epochNumber: 4,
+			msgs:        []sdk.Msg{updateDuration},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authority := tc.authority
+			if authority == "" {
+				authority = k.GetAuthority()
+			}
+			msg, err := types.NewMsgScheduleExecution(authority, tc.identifier, tc.epochNumber, tc.msgs)
+			require.NoError(t, err)
+
+			res, err := ms.ScheduleExecution(ctx, msg)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), res.Id)
+		})
+	}
+
+	res, err := k.ScheduledExecutions(ctx, &types.QueryScheduledExecutionsRequest{Identifier: types.DayEpochID})
+	require.NoError(t, err)
+	require.Len(t, res.ScheduledExecutions, 1)
+	require.Equal(t, int64(4), res.ScheduledExecutions[0].EpochNumber)
+}
+
+func TestScheduledExecution(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.DayEpochID, Duration: time.Hour * 24, CurrentEpoch: 1, EpochCountingStarted: true})
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.WeekEpochID, Duration: time.Hour * 24 * 7})
+
+	schedule := func(epochNumber int64, msgs ...sdk.Msg) uint64 {
+		msg, err := types.NewMsgScheduleExecution(k.GetAuthority(), types.DayEpochID, epochNumber, msgs)
+		require.NoError(t, err)
+		res, err := ms.ScheduleExecution(ctx, msg)
+		require.NoError(t, err)
+		return res.Id
+	}
+
+	// the second message fails, so the pause of the first one is reverted
+	failing := schedule(2,
+		&types.MsgPauseEpoch{Authority: k.GetAuthority(), Identifier: types.WeekEpochID},
+		&types.MsgDeleteEpochInfo{Authority: k.GetAuthority(), Identifier: "unknown"},
+	)
+	succeeding := schedule(3, &types.MsgUpdateEpochDuration{Authority: k.GetAuthority(), Identifier: types.WeekEpochID, Duration: time.Hour})
+	cancelled := schedule(3, &types.MsgPauseEpoch{Authority: k.GetAuthority(), Identifier: types.WeekEpochID})
+	later := schedule(5, &types.MsgPauseEpoch{Authority: k.GetAuthority(), Identifier: types.WeekEpochID})
+
+	_, err := ms.CancelExecution(ctx, &types.MsgCancelExecution{Authority: k.GetAuthority(), Id: cancelled})
+	require.NoError(t, err)
+	_, err = ms.CancelExecution(ctx, &types.MsgCancelExecution{Authority: k.GetAuthority(), Id: cancelled})
+	require.ErrorContains(t, err, "not found")
+
+	res, err := k.ScheduledExecutions(ctx, &types.QueryScheduledExecutionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.ScheduledExecutions, 3)
+
+	// epoch 2 was skipped, so its execution runs at the start of epoch 3
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.BeforeEpochStart(ctx, types.EpochContext{Identifier: types.DayEpochID, EpochNumber: 3})
+
+	week, found := k.GetEpochInfo(ctx, types.WeekEpochID)
+	require.True(t, found)
+	require.False(t, week.Paused)
+	require.Equal(t, time.Hour, week.Duration)
+
+	results := make(map[string]string)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeScheduledExecution {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		results[attributes[types.AttributeScheduledExecutionID]] = attributes[types.AttributeResult]
+	}
+	require.Equal(t, map[string]string{
+		"1": types.AttributeValueFailure,
+		"2": types.AttributeValueSuccess,
+	}, results)
+
+	for _, id := range []uint64{failing, succeeding} {
+		_, found := k.GetScheduledExecution(ctx, id)
+		require.False(t, found)
+	}
+
+	pending, err := k.ScheduledExecution(ctx, &types.QueryScheduledExecutionRequest{Id: later})
+	require.NoError(t, err)
+	require.Equal(t, int64(5), pending.ScheduledExecution.EpochNumber)
+
+	// deleting the epoch identifier removes its scheduled executions
+	_, err = ms.DeleteEpochInfo(ctx, &types.MsgDeleteEpochInfo{Authority: k.GetAuthority(), Identifier: types.DayEpochID})
+	require.NoError(t, err)
+	_, err = k.ScheduledExecution(ctx, &types.QueryScheduledExecutionRequest{Id: later})
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestMsgUpdateEpochDuration(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	k.SetEpochInfo(ctx, types.EpochInfo{Identifier: types.DayEpochID, Duration: time.Hour * 24})
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateEpochDuration
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateEpochDuration{
+				Authority:  "invalid",
+				Identifier: types.DayEpochID,
+				Duration:   time.Hour,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "not found",
+			input: &types.MsgUpdateEpochDuration{
+				Authority:  k.GetAuthority(),
+				Identifier: types.WeekEpochID,
+				Duration:   time.Hour,
+			},
+			expErr:    true,
+			expErrMsg: "not found",
+		},
+		{
+			name: "zero duration",
+			input: &types.MsgUpdateEpochDuration{
+				Authority:  k.GetAuthority(),
+				Identifier: types.DayEpochID,
+			},
+			expErr:    true,
+			expErrMsg: "epoch duration cannot be 0",
+		},
+		{
+			name: "all good",
+			input: &types.MsgUpdateEpochDuration{
+				Authority:  k.GetAuthority(),
+				Identifier: types.DayEpochID,
+				Duration:   time.Hour * 12,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateEpochDuration(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	epoch, found := k.GetEpochInfo(ctx, types.DayEpochID)
+	require.True(t, found)
+	require.Equal(t, time.Hour*12, epoch.Duration)
+}
@@ -18,6 +18,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/Galactica-corp/galactica/x/epochs/types"
 
@@ -79,6 +81,235 @@ func (k Keeper) CurrentEpoch(
 	}
 
 	return &types.QueryCurrentEpochResponse{
-		CurrentEpoch: info.CurrentEpoch,
+		CurrentEpoch:            info.CurrentEpoch,
+		CurrentEpochStartHeight: info.CurrentEpochStartHeight,
+		DurationBlocks:          info.DurationBlocks,
 	}, nil
 }
+
+// EpochInfo provides the epoch info of the specified identifier
+func (k Keeper) EpochInfo(
+	c context.Context,
+	req *types.QueryEpochInfoRequest,
+) (*types.QueryEpochInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	return &types.QueryEpochInfoResponse{
+		Epoch: info,
+	}, nil
+}
+
+// NextEpoch provides the projected end of the current epoch of the specified
+// identifier, based on the latest block and the average block time
+func (k Keeper) NextEpoch(
+	c context.Context,
+	req *types.QueryNextEpochRequest,
+) (*types.QueryNextEpochResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	height, now := ctx.BlockHeight(), ctx.BlockTime()
+	avg := k.GetAverageBlockTime(ctx)
+
+	var (
+		endTime   time.Time
+		endHeight int64
+	)
+	switch {
+	case !info.EpochCountingStarted:
+		endTime = info.StartTime
+		endHeight = estimateEndHeight(height, now, endTime, avg)
+	case info.IsBlockBased():
+		endHeight = max(info.CurrentEpochStartHeight+info.DurationBlocks, height+1)
+		endTime = now.Add(time.Duration(endHeight-height) * avg)
+	default:
+		endTime = info.EpochEndTime()
+		endHeight = estimateEndHeight(height, now, endTime, avg)
+	}
+
+	return &types.QueryNextEpochResponse{
+		CurrentEpoch:            info.CurrentEpoch,
+		CurrentEpochStartHeight: info.CurrentEpochStartHeight,
+		EndTime:                 endTime,
+		SecondsRemaining:        max(int64(endTime.Sub(now)/time.Second), 0),
+		EstimatedEndHeight:      endHeight,
+		AverageBlockTime:        avg,
+	}, nil
+}
+
+// estimateEndHeight returns the height of the first block after endTime,
+// assuming blocks are produced every avg. It returns zero if no average block
+// time is known.
+func estimateEndHeight(height int64, now, endTime time.Time, avg time.Duration) int64 {
+	if avg <= 0 {
+		return 0
+	}
+	remaining := endTime.Sub(now)
+	if remaining < 0 {
+		return height + 1
+	}
+	return height + int64(remaining/avg) + 1
+}
+
+// EpochHistory provides the records of the completed epochs of the specified
+// identifier
+func (k Keeper) EpochHistory(
+	c context.Context,
+	req *types.QueryEpochHistoryRequest,
+) (*types.QueryEpochHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := types.ValidateEpochIdentifierString(req.Identifier); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records []types.EpochRecord
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEpochHistory)
+	store := prefix.NewStore(historyStore, types.EpochHistoryPrefix(req.Identifier))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.EpochRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochHistoryResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// EpochStats provides the chain activity statistics of the current or a
+// completed epoch of the specified identifier
+func (k Keeper) EpochStats(
+	c context.Context,
+	req *types.QueryEpochStatsRequest,
+) (*types.QueryEpochStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.EpochNumber < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch number cannot be negative: %d", req.EpochNumber)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetEpochInfo(ctx, req.Identifier)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch info not found: %s", req.Identifier)
+	}
+
+	if req.EpochNumber == 0 || req.EpochNumber == info.CurrentEpoch {
+		return &types.QueryEpochStatsResponse{
+			EpochNumber: info.CurrentEpoch,
+			Stats:       info.Stats,
+		}, nil
+	}
+
+	record, found := k.GetEpochRecord(ctx, req.Identifier, req.EpochNumber)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "epoch record not found: %s %d", req.Identifier, req.EpochNumber)
+	}
+
+	return &types.QueryEpochStatsResponse{
+		EpochNumber: record.EpochNumber,
+		Completed:   true,
+		Stats:       record.Stats,
+	}, nil
+}
+
+// ScheduledExecutions provides the pending scheduled executions, optionally
+// filtered by epoch identifier
+func (k Keeper) ScheduledExecutions(
+	c context.Context,
+	req *types.QueryScheduledExecutionsRequest,
+) (*types.QueryScheduledExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var (
+		executions []types.ScheduledExecution
+		pageRes    *query.PageResponse
+		err        error
+	)
+	if req.Identifier == "" {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecution)
+		pageRes, err = query.Paginate(store, req.Pagination, func(_, value []byte) error {
+			var execution types.ScheduledExecution
+			if err := k.cdc.Unmarshal(value, &execution); err != nil {
+				return err
+			}
+			executions = append(executions, execution)
+			return nil
+		})
+	} else {
+		queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecutionQueue)
+		store := prefix.NewStore(queue, types.ScheduledExecutionQueuePrefix(req.Identifier))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+			_, id := types.SplitScheduledExecutionQueueKey(key)
+			execution, found := k.GetScheduledExecution(ctx, id)
+			if !found {
+				return fmt.Errorf("scheduled execution %d not found", id)
+			}
+			executions = append(executions, execution)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduledExecutionsResponse{
+		ScheduledExecutions: executions,
+		Pagination:          pageRes,
+	}, nil
+}
+
+// ScheduledExecution provides the pending scheduled execution of the specified
+// id
+func (k Keeper) ScheduledExecution(
+	c context.Context,
+	req *types.QueryScheduledExecutionRequest,
+) (*types.QueryScheduledExecutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	execution, found := k.GetScheduledExecution(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "scheduled execution not found: %d", req.Id)
+	}
+
+	return &types.QueryScheduledExecutionResponse{ScheduledExecution: execution}, nil
+}
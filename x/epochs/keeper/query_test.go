@@ -0,0 +1,137 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestEpochInfoQuery(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	epoch := types.EpochInfo{Identifier: types.DayEpochID, Duration: time.Hour * 24}
+	k.SetEpochInfo(ctx, epoch)
+
+	res, err := k.EpochInfo(ctx, &types.QueryEpochInfoRequest{Identifier: types.DayEpochID})
+	require.NoError(t, err)
+	require.Equal(t, epoch, res.Epoch)
+
+	_, err = k.EpochInfo(ctx, &types.QueryEpochInfoRequest{Identifier: types.WeekEpochID})
+	require.ErrorContains(t, err, "not found")
+
+	_, err = k.EpochInfo(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestTrackBlockTime(t *testing.T) {
+	k, ctx := keepertest.EpochsKeeper(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	k.TrackBlockTime(ctx.WithBlockTime(start))
+	require.Zero(t, k.GetAverageBlockTime(ctx))
+
+	// the first interval initialises the average
+	k.TrackBlockTime(ctx.WithBlockTime(start.Add(time.Second * 5)))
+	require.Equal(t, time.Second*5, k.GetAverageBlockTime(ctx))
+
+	// later intervals move it by 1/BlockTimeWindow of the difference
+	k.TrackBlockTime(ctx.WithBlockTime(start.Add(time.Second * 15)))
+	require.Equal(t, time.Second*5+time.Second*5/types.BlockTimeWindow, k.GetAverageBlockTime(ctx))
+}
+
+func TestNextEpochQuery(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start.Add(time.Minute * 30)
+
+	testCases := []struct {
+		name     string
+		epoch    types.EpochInfo
+		expected *types.QueryNextEpochResponse
+	}{
+		{
+			name: "time based",
+			epoch: types.EpochInfo{
+				Identifier:              types.HourEpochID,
+				StartTime:               start,
+				Duration:                time.Hour,
+				CurrentEpoch:            1,
+				CurrentEpochStartTime:   start,
+				CurrentEpochStartHeight: 100,
+				EpochCountingStarted:    true,
+			},
+			expected: &types.QueryNextEpochResponse{
+				CurrentEpoch:            1,
+				CurrentEpochStartHeight: 100,
+				EndTime:                 start.Add(time.Hour),
+				SecondsRemaining:        1800,
+				EstimatedEndHeight:      460 + 1,
+				AverageBlockTime:        time.Second * 5,
+			},
+		},
+		{
+			name: "block based",
+			epoch: types.EpochInfo{
+				Identifier:              "thousand_blocks",
+				StartTime:               start,
+				DurationBlocks:          1000,
+				CurrentEpoch:            1,
+				CurrentEpochStartTime:   start,
+				CurrentEpochStartHeight: 100,
+				EpochCountingStarted:    true,
+			},
+			expected: &types.QueryNextEpochResponse{
+				CurrentEpoch:            1,
+				CurrentEpochStartHeight: 100,
+				EndTime:                 now.Add(time.Second * 5 * 1000),
+				SecondsRemaining:        5000,
+				EstimatedEndHeight:      1100,
+				AverageBlockTime:        time.Second * 5,
+			},
+		},
+		{
+			name: "not started",
+			epoch: types.EpochInfo{
+				Identifier: types.DayEpochID,
+				StartTime:  now.Add(time.Minute),
+				Duration:   time.Hour * 24,
+			},
+			expected: &types.QueryNextEpochResponse{
+				EndTime:            now.Add(time.Minute),
+				SecondsRemaining:   60,
+				EstimatedEndHeight: 112 + 1,
+				AverageBlockTime:   time.Second * 5,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := keepertest.EpochsKeeper(t)
+			k.TrackBlockTime(ctx.WithBlockTime(now.Add(-time.Second * 5)))
+			k.TrackBlockTime(ctx.WithBlockTime(now))
+			k.SetEpochInfo(ctx, tc.epoch)
+
+			ctx = ctx.WithBlockHeight(100).WithBlockTime(now)
+			res, err := k.NextEpoch(ctx, &types.QueryNextEpochRequest{Identifier: tc.epoch.Identifier})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res)
+		})
+	}
+}
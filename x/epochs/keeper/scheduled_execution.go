@@ -0,0 +1,266 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// GetNextScheduledExecutionID returns the id of the next scheduled execution
+func (k Keeper) GetNextScheduledExecutionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextScheduledExecutionID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduledExecutionID sets the id of the next scheduled execution
+func (k Keeper) SetNextScheduledExecutionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextScheduledExecutionID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetScheduledExecution returns the scheduled execution of the given id
+func (k Keeper) GetScheduledExecution(ctx sdk.Context, id uint64) (types.ScheduledExecution, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecution)
+	bz := store.Get(types.ScheduledExecutionKey(id))
+	if bz == nil {
+		return types.ScheduledExecution{}, false
+	}
+
+	var execution types.ScheduledExecution
+	k.cdc.MustUnmarshal(bz, &execution)
+	return execution, true
+}
+
+// SetScheduledExecution stores a scheduled execution and adds it to the queue
+// of its epoch identifier
+func (k Keeper) SetScheduledExecution(ctx sdk.Context, execution types.ScheduledExecution) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecution)
+	store.Set(types.ScheduledExecutionKey(execution.Id), k.cdc.MustMarshal(&execution))
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecutionQueue)
+	queue.Set(types.ScheduledExecutionQueueKey(execution.Identifier, execution.EpochNumber, execution.Id), []byte{})
+}
+
+// DeleteScheduledExecution removes a scheduled execution and its queue entry
+func (k Keeper) DeleteScheduledExecution(ctx sdk.Context, execution types.ScheduledExecution) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecution)
+	store.Delete(types.ScheduledExecutionKey(execution.Id))
+
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecutionQueue)
+	queue.Delete(types.ScheduledExecutionQueueKey(execution.Identifier, execution.EpochNumber, execution.Id))
+}
+
+// DeleteScheduledExecutions removes all scheduled executions of the given
+// epoch identifier
+func (k Keeper) DeleteScheduledExecutions(ctx sdk.Context, identifier string) {
+	var executions []types.ScheduledExecution
+	k.IterateScheduledExecutionQueue(ctx, identifier, func(execution types.ScheduledExecution) (stop bool) {
+		executions = append(executions, execution)
+		return false
+	})
+
+	for _, execution := range executions {
+		k.DeleteScheduledExecution(ctx, execution)
+	}
+}
+
+// IterateScheduledExecutions iterates over all scheduled executions ordered by
+// id
+func (k Keeper) IterateScheduledExecutions(ctx sdk.Context, fn func(execution types.ScheduledExecution) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecution)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var execution types.ScheduledExecution
+		k.cdc.MustUnmarshal(iterator.Value(), &execution)
+
+		if fn(execution) {
+			break
+		}
+	}
+}
+
+// IterateScheduledExecutionQueue iterates over the scheduled executions of
+// the given epoch identifier ordered by epoch number and id
+func (k Keeper) IterateScheduledExecutionQueue(ctx sdk.Context, identifier string, fn func(execution types.ScheduledExecution) (stop bool)) {
+	queue := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixScheduledExecutionQueue)
+	store := prefix.NewStore(queue, types.ScheduledExecutionQueuePrefix(identifier))
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.SplitScheduledExecutionQueueKey(iterator.Key())
+		execution, found := k.GetScheduledExecution(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("scheduled execution %d of epoch %s not found", id, identifier))
+		}
+
+		if fn(execution) {
+			break
+		}
+	}
+}
+
+// AllScheduledExecutions returns all scheduled executions ordered by id
+func (k Keeper) AllScheduledExecutions(ctx sdk.Context) []types.ScheduledExecution {
+	executions := []types.ScheduledExecution{}
+	k.IterateScheduledExecutions(ctx, func(execution types.ScheduledExecution) (stop bool) {
+		executions = append(executions, execution)
+		return false
+	})
+	return executions
+}
+
+// ScheduleExecution schedules the given messages for execution at the start
+// of an upcoming epoch. The messages must be routable and signed by the module
+// authority only.
+func (k Keeper) ScheduleExecution(ctx sdk.Context, identifier string, epochNumber int64, msgs []sdk.Msg) (uint64, error) {
+	epochInfo, found := k.GetEpochInfo(ctx, identifier)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrEpochInfoNotFound, "identifier %s", identifier)
+	}
+	if epochNumber <= epochInfo.CurrentEpoch {
+		return 0, errorsmod.Wrapf(types.ErrInvalidScheduledExecution,
+			"epoch %d of %s is not after the current epoch %d", epochNumber, identifier, epochInfo.CurrentEpoch)
+	}
+
+	for i, msg := range msgs {
+		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return 0, errorsmod.Wrapf(types.ErrInvalidScheduledExecution, "message %d: %s", i, err)
+		}
+		if len(signers) != 1 || sdk.AccAddress(signers[0]).String() != k.authority {
+			return 0, errorsmod.Wrapf(types.ErrInvalidSigner, "message %d must be signed by the authority %s only", i, k.authority)
+		}
+		if k.router.Handler(msg) == nil {
+			return 0, errorsmod.Wrapf(types.ErrInvalidScheduledExecution, "message %d: unroutable message %s", i, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	id := k.GetNextScheduledExecutionID(ctx)
+	execution, err := types.NewScheduledExecution(id, identifier, epochNumber, msgs)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidScheduledExecution, err.Error())
+	}
+
+	k.SetScheduledExecution(ctx, execution)
+	k.SetNextScheduledExecutionID(ctx, id+1)
+
+	return id, nil
+}
+
+// executeScheduledExecutions runs and removes the scheduled executions of the
+// given epoch identifier up to the given epoch number. Executions of earlier
+// epochs, which were skipped during a catch up, run as well.
+func (k Keeper) executeScheduledExecutions(ctx sdk.Context, identifier string, epochNumber int64) {
+	var due []types.ScheduledExecution
+	k.IterateScheduledExecutionQueue(ctx, identifier, func(execution types.ScheduledExecution) (stop bool) {
+		if execution.EpochNumber > epochNumber {
+			return true
+		}
+		due = append(due, execution)
+		return false
+	})
+
+	for _, execution := range due {
+		k.DeleteScheduledExecution(ctx, execution)
+		k.runScheduledExecution(ctx, execution, epochNumber)
+	}
+}
+
+// runScheduledExecution executes the messages of a scheduled execution
+// atomically in a cached context, which is only written if all of them
+// succeed
+func (k Keeper) runScheduledExecution(ctx sdk.Context, execution types.ScheduledExecution, epochNumber int64) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeScheduledExecutionID, strconv.FormatUint(execution.Id, 10)),
+		sdk.NewAttribute(types.AttributeEpochIdentifier, execution.Identifier),
+		sdk.NewAttribute(types.AttributeEpochNumber, strconv.FormatInt(epochNumber, 10)),
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	events, err := k.executeMsgs(cacheCtx, execution)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"scheduled execution failed",
+			"id", execution.Id,
+			"identifier", execution.Identifier,
+			"error", err.Error(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduledExecution,
+				append(attributes,
+					sdk.NewAttribute(types.AttributeResult, types.AttributeValueFailure),
+					sdk.NewAttribute(types.AttributeError, err.Error()),
+				)...,
+			),
+		)
+		return
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(events)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduledExecution,
+			append(attributes, sdk.NewAttribute(types.AttributeResult, types.AttributeValueSuccess))...,
+		),
+	)
+}
+
+func (k Keeper) executeMsgs(ctx sdk.Context, execution types.ScheduledExecution) (events sdk.Events, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	msgs, err := execution.GetMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, msg := range msgs {
+		handler := k.router.Handler(msg)
+		if handler == nil {
+			return nil, fmt.Errorf("message %d: unroutable message %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "message %d", i)
+		}
+		events = append(events, res.GetEvents()...)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,76 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The v2 store
+// adds block-based epochs and the catch up parameters:
+//
+// - params without max_epochs_per_block are set to process one epoch per block,
+// values above the limit are capped to it
+// - every stored epoch info must be valid under the v2 rules, existing epochs
+// keep being time-based
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+	if params.MaxEpochsPerBlock == 0 {
+		params.MaxEpochsPerBlock = types.DefaultParams().MaxEpochsPerBlock
+	}
+	if params.MaxEpochsPerBlock > types.MaxEpochsPerBlockLimit {
+		params.MaxEpochsPerBlock = types.MaxEpochsPerBlockLimit
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	epochStore := prefix.NewStore(store, types.KeyPrefixEpoch)
+	iterator := epochStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var epoch types.EpochInfo
+		if err := cdc.Unmarshal(iterator.Value(), &epoch); err != nil {
+			return err
+		}
+		if err := epoch.Validate(); err != nil {
+			return fmt.Errorf("invalid epoch info %s: %w", epoch.Identifier, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/Galactica-corp/galactica/x/epochs/migrations/v2"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	epoch := types.EpochInfo{
+		Identifier:   types.DayEpochID,
+		StartTime:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Duration:     time.Hour * 24,
+		CurrentEpoch: 3,
+	}
+	store.Set(append(types.KeyPrefixEpoch, []byte(epoch.Identifier)...), cdc.MustMarshal(&epoch))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, types.DefaultParams(), params)
+
+	var migrated types.EpochInfo
+	cdc.MustUnmarshal(store.Get(append(types.KeyPrefixEpoch, []byte(epoch.Identifier)...)), &migrated)
+	require.Equal(t, epoch, migrated)
+	require.False(t, migrated.IsBlockBased())
+
+	// max epochs per block above the limit is capped
+	store.Set(types.ParamsKey, cdc.MustMarshal(&types.Params{MaxEpochsPerBlock: 1 << 63}))
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, types.MaxEpochsPerBlockLimit, params.MaxEpochsPerBlock)
+
+	// an invalid epoch info aborts the migration
+	invalid := types.EpochInfo{Identifier: types.WeekEpochID}
+	store.Set(append(types.KeyPrefixEpoch, []byte(invalid.Identifier)...), cdc.MustMarshal(&invalid))
+	require.Error(t, v2.MigrateStore(ctx, storeKey, cdc))
+}
@@ -0,0 +1,79 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding epochs type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpoch):
+			var epochA, epochB types.EpochInfo
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyLastBlockTime):
+			timeA := time.Unix(0, int64(sdk.BigEndianToUint64(kvA.Value))).UTC()
+			timeB := time.Unix(0, int64(sdk.BigEndianToUint64(kvB.Value))).UTC()
+			return fmt.Sprintf("%v\n%v", timeA, timeB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyAverageBlockTime):
+			return fmt.Sprintf("%v\n%v", time.Duration(sdk.BigEndianToUint64(kvA.Value)), time.Duration(sdk.BigEndianToUint64(kvB.Value)))
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixEpochHistory):
+			var recordA, recordB types.EpochRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixScheduledExecution):
+			var executionA, executionB types.ScheduledExecution
+			cdc.MustUnmarshal(kvA.Value, &executionA)
+			cdc.MustUnmarshal(kvB.Value, &executionB)
+			return fmt.Sprintf("%v\n%v", executionA, executionB)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyPrefixScheduledExecutionQueue):
+			// queue entries carry no value, the key holds the execution
+			return fmt.Sprintf("%X\n%X", kvA.Key, kvB.Key)
+
+		case bytes.Equal(kvA.Key[:1], types.KeyNextScheduledExecutionID):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
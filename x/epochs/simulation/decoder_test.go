@@ -0,0 +1,82 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/epochs/simulation"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	epoch := types.EpochInfo{Identifier: types.DayEpochID, StartTime: startTime, Duration: 24 * time.Hour}
+	record := types.EpochRecord{Identifier: types.DayEpochID, EpochNumber: 1, StartTime: startTime, EndTime: startTime.Add(24 * time.Hour)}
+	execution := types.ScheduledExecution{Id: 1, Identifier: types.DayEpochID, EpochNumber: 2}
+	params := types.DefaultParams()
+	queueKey := append(types.KeyPrefixScheduledExecutionQueue, types.ScheduledExecutionQueueKey(types.DayEpochID, 2, 1)...)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: append(types.KeyPrefixEpoch, []byte(types.DayEpochID)...), Value: cdc.MustMarshal(&epoch)},
+			{Key: types.KeyLastBlockTime, Value: sdk.Uint64ToBigEndian(uint64(startTime.UnixNano()))},
+			{Key: types.KeyAverageBlockTime, Value: sdk.Uint64ToBigEndian(uint64(5 * time.Second))},
+			{Key: append(types.KeyPrefixEpochHistory, types.EpochHistoryKey(types.DayEpochID, 1)...), Value: cdc.MustMarshal(&record)},
+			{Key: append(types.KeyPrefixScheduledExecution, types.ScheduledExecutionKey(1)...), Value: cdc.MustMarshal(&execution)},
+			{Key: queueKey, Value: []byte{}},
+			{Key: types.KeyNextScheduledExecutionID, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"EpochInfo", fmt.Sprintf("%v\n%v", epoch, epoch)},
+		{"LastBlockTime", fmt.Sprintf("%v\n%v", startTime, startTime)},
+		{"AverageBlockTime", "5s\n5s"},
+		{"EpochRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"ScheduledExecution", fmt.Sprintf("%v\n%v", execution, execution)},
+		{"ScheduledExecutionQueue", fmt.Sprintf("%X\n%X", queueKey, queueKey)},
+		{"NextScheduledExecutionID", "2\n2"},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if i == len(tests)-1 {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// Simulation parameter constants
+const (
+	CatchUpPolicy     = "catch_up_policy"
+	MaxEpochsPerBlock = "max_epochs_per_block"
+	HistoryRetention  = "history_retention"
+	Epochs            = "epochs"
+)
+
+// GenCatchUpPolicy randomized CatchUpPolicy
+func GenCatchUpPolicy(r *rand.Rand) types.CatchUpPolicy {
+	return types.CatchUpPolicy(r.Intn(len(types.CatchUpPolicy_name)))
+}
+
+// GenMaxEpochsPerBlock randomized MaxEpochsPerBlock
+func GenMaxEpochsPerBlock(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 5))
+}
+
+// GenHistoryRetention randomized HistoryRetention
+func GenHistoryRetention(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 0, 50))
+}
+
+// GenEpochs randomized epochs. The default epochs are always included as
+// other modules depend on their identifiers, followed by up to four
+// simulation epochs that are time-based, block-based or calendar aligned.
+func GenEpochs(r *rand.Rand, genesisTime time.Time) []types.EpochInfo {
+	epochs := types.DefaultGenesis().Epochs
+	n := r.Intn(5)
+	for i := 0; i < n; i++ {
+		epoch := RandomEpochInfo(r, fmt.Sprintf("sim%d", i))
+		// epochs start up to one hour after genesis
+		epoch.StartTime = genesisTime.Add(time.Duration(r.Int63n(int64(time.Hour))))
+		epochs = append(epochs, epoch)
+	}
+	return epochs
+}
+
+// RandomEpochInfo returns a not yet started epoch with a random duration
+func RandomEpochInfo(r *rand.Rand, identifier string) types.EpochInfo {
+	epoch := types.EpochInfo{Identifier: identifier}
+	switch r.Intn(3) {
+	case 0:
+		epoch.Duration = RandomEpochDuration(r)
+	case 1:
+		epoch.DurationBlocks = RandomEpochDurationBlocks(r)
+	default:
+		epoch.Alignment = types.CalendarAlignment(simtypes.RandIntBetween(r, 1, len(types.CalendarAlignment_name)))
+	}
+	return epoch
+}
+
+// RandomEpochDuration returns a random epoch duration between one minute and
+// six hours
+func RandomEpochDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 360)) * time.Minute
+}
+
+// RandomEpochDurationBlocks returns a random epoch length between 1 and 100
+// blocks
+func RandomEpochDurationBlocks(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// RandomizedGenState generates a random GenesisState for epochs
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		catchUpPolicy     types.CatchUpPolicy
+		maxEpochsPerBlock uint64
+		historyRetention  uint64
+		epochs            []types.EpochInfo
+	)
+
+	simState.AppParams.GetOrGenerate(CatchUpPolicy, &catchUpPolicy, simState.Rand, func(r *rand.Rand) {
+		catchUpPolicy = GenCatchUpPolicy(r)
+	})
+	simState.AppParams.GetOrGenerate(MaxEpochsPerBlock, &maxEpochsPerBlock, simState.Rand, func(r *rand.Rand) {
+		maxEpochsPerBlock = GenMaxEpochsPerBlock(r)
+	})
+	simState.AppParams.GetOrGenerate(HistoryRetention, &historyRetention, simState.Rand, func(r *rand.Rand) {
+		historyRetention = GenHistoryRetention(r)
+	})
+	simState.AppParams.GetOrGenerate(Epochs, &epochs, simState.Rand, func(r *rand.Rand) {
+		epochs = GenEpochs(r, simState.GenTimestamp)
+	})
+
+	epochsGenesis := types.GenesisState{
+		Params: types.NewParams(catchUpPolicy, maxEpochsPerBlock, historyRetention),
+		Epochs: epochs,
+	}
+
+	bz, err := json.MarshalIndent(&epochsGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epochs parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&epochsGenesis)
+}
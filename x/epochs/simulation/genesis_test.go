@@ -0,0 +1,68 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/epochs"
+	"github.com/Galactica-corp/galactica/x/epochs/simulation"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(epochs.AppModuleBasic{})
+	genesisTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+
+		simState := module.SimulationState{
+			AppParams:    make(simtypes.AppParams),
+			Cdc:          encCfg.Codec,
+			Rand:         r,
+			NumBonded:    3,
+			BondDenom:    sdk.DefaultBondDenom,
+			Accounts:     simtypes.RandomAccounts(r, 3),
+			InitialStake: sdkmath.NewInt(1000),
+			GenState:     make(map[string]json.RawMessage),
+			GenTimestamp: genesisTime,
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var epochsGenesis types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &epochsGenesis)
+
+		require.NoError(t, epochsGenesis.Validate())
+		require.GreaterOrEqual(t, len(epochsGenesis.Epochs), 2)
+		require.Equal(t, types.WeekEpochID, epochsGenesis.Epochs[0].Identifier)
+		require.Equal(t, types.DayEpochID, epochsGenesis.Epochs[1].Identifier)
+		for _, epoch := range epochsGenesis.Epochs[2:] {
+			require.False(t, epoch.StartTime.Before(genesisTime))
+			require.True(t, epoch.StartTime.Before(genesisTime.Add(time.Hour)))
+		}
+	}
+}
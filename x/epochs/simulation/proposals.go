@@ -0,0 +1,226 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/Galactica-corp/galactica/x/epochs/keeper"
+	"github.com/Galactica-corp/galactica/x/epochs/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams        int = 20
+	DefaultWeightMsgCreateEpochInfo     int = 30
+	DefaultWeightMsgUpdateEpochDuration int = 20
+	DefaultWeightMsgDeleteEpochInfo     int = 10
+	DefaultWeightMsgPauseEpoch          int = 10
+	DefaultWeightMsgResumeEpoch         int = 20
+	DefaultWeightMsgScheduleExecution   int = 20
+	DefaultWeightMsgCancelExecution     int = 10
+
+	OpWeightMsgUpdateParams        = "op_weight_msg_update_params"
+	OpWeightMsgCreateEpochInfo     = "op_weight_msg_create_epoch_info"
+	OpWeightMsgUpdateEpochDuration = "op_weight_msg_update_epoch_duration"
+	OpWeightMsgDeleteEpochInfo     = "op_weight_msg_delete_epoch_info"
+	OpWeightMsgPauseEpoch          = "op_weight_msg_pause_epoch"
+	OpWeightMsgResumeEpoch         = "op_weight_msg_resume_epoch"
+	OpWeightMsgScheduleExecution   = "op_weight_msg_schedule_execution"
+	OpWeightMsgCancelExecution     = "op_weight_msg_cancel_execution"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents. All messages
+// of the module are signed by the authority, so they are simulated as the
+// messages of governance proposals.
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(OpWeightMsgUpdateParams, DefaultWeightMsgUpdateParams, SimulateMsgUpdateParams(k)),
+		simulation.NewWeightedProposalMsg(OpWeightMsgCreateEpochInfo, DefaultWeightMsgCreateEpochInfo, SimulateMsgCreateEpochInfo(k)),
+		simulation.NewWeightedProposalMsg(OpWeightMsgUpdateEpochDuration, DefaultWeightMsgUpdateEpochDuration, SimulateMsgUpdateEpochDuration(k)),
+		simulation.NewWeightedProposalMsg(OpWeightMsgDeleteEpochInfo, DefaultWeightMsgDeleteEpochInfo, SimulateMsgDeleteEpochInfo(k)),
+		simulation.NewWeightedProposalMsg(OpWeightMsgPauseEpoch, DefaultWeightMsgPauseEpoch, SimulateMsgPauseEpoch(k)),
+		simulation.NewWeightedProposalMsg(OpWeightMsgResumeEpoch, DefaultWeightMsgResumeEpoch, SimulateMsgResumeEpoch(k)),
+		simulation.NewWeightedProposalMsg(OpWeightMsgScheduleExecution, DefaultWeightMsgScheduleExecution, SimulateMsgScheduleExecution(k)),
+		simulation.NewWeightedProposalMsg(OpWeightMsgCancelExecution, DefaultWeightMsgCancelExecution, SimulateMsgCancelExecution(k)),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+		return &types.MsgUpdateParams{
+			Authority: k.GetAuthority(),
+			Params:    types.NewParams(GenCatchUpPolicy(r), GenMaxEpochsPerBlock(r), GenHistoryRetention(r)),
+		}
+	}
+}
+
+// SimulateMsgCreateEpochInfo returns a MsgCreateEpochInfo for a random new
+// identifier
+func SimulateMsgCreateEpochInfo(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		identifier := "sim" + simtypes.RandStringOfLength(r, 6)
+		if _, found := k.GetEpochInfo(ctx, identifier); found {
+			return nil
+		}
+
+		epoch := RandomEpochInfo(r, identifier)
+		return &types.MsgCreateEpochInfo{
+			Authority:      k.GetAuthority(),
+			Identifier:     identifier,
+			StartTime:      ctx.BlockTime().Add(time.Duration(r.Int63n(int64(time.Hour)))),
+			Duration:       epoch.Duration,
+			DurationBlocks: epoch.DurationBlocks,
+			Alignment:      epoch.Alignment,
+		}
+	}
+}
+
+// SimulateMsgUpdateEpochDuration returns a MsgUpdateEpochDuration for a
+// random epoch that is not calendar aligned
+func SimulateMsgUpdateEpochDuration(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		epoch, found := randomEpochInfo(r, ctx, k, func(epoch types.EpochInfo) bool {
+			return !epoch.IsCalendarAligned()
+		})
+		if !found {
+			return nil
+		}
+
+		msg := &types.MsgUpdateEpochDuration{
+			Authority:  k.GetAuthority(),
+			Identifier: epoch.Identifier,
+		}
+		if r.Intn(2) == 0 {
+			msg.Duration = RandomEpochDuration(r)
+		} else {
+			msg.DurationBlocks = RandomEpochDurationBlocks(r)
+		}
+		return msg
+	}
+}
+
+// SimulateMsgDeleteEpochInfo returns a MsgDeleteEpochInfo for a random epoch.
+// The default epochs are kept as other modules depend on their identifiers.
+func SimulateMsgDeleteEpochInfo(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		epoch, found := randomEpochInfo(r, ctx, k, func(epoch types.EpochInfo) bool {
+			return epoch.Identifier != types.DayEpochID && epoch.Identifier != types.WeekEpochID
+		})
+		if !found {
+			return nil
+		}
+
+		return &types.MsgDeleteEpochInfo{
+			Authority:  k.GetAuthority(),
+			Identifier: epoch.Identifier,
+		}
+	}
+}
+
+// SimulateMsgPauseEpoch returns a MsgPauseEpoch for a random running epoch
+func SimulateMsgPauseEpoch(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		epoch, found := randomEpochInfo(r, ctx, k, func(epoch types.EpochInfo) bool {
+			return !epoch.Paused
+		})
+		if !found {
+			return nil
+		}
+
+		return &types.MsgPauseEpoch{
+			Authority:  k.GetAuthority(),
+			Identifier: epoch.Identifier,
+		}
+	}
+}
+
+// SimulateMsgResumeEpoch returns a MsgResumeEpoch for a random paused epoch
+func SimulateMsgResumeEpoch(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		epoch, found := randomEpochInfo(r, ctx, k, func(epoch types.EpochInfo) bool {
+			return epoch.Paused
+		})
+		if !found {
+			return nil
+		}
+
+		return &types.MsgResumeEpoch{
+			Authority:  k.GetAuthority(),
+			Identifier: epoch.Identifier,
+			Reanchor:   r.Intn(2) == 0,
+		}
+	}
+}
+
+// SimulateMsgScheduleExecution returns a MsgScheduleExecution of a random
+// params update in one of the next epochs of a random epoch
+func SimulateMsgScheduleExecution(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	updateParams := SimulateMsgUpdateParams(k)
+
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+		epoch, found := randomEpochInfo(r, ctx, k, func(types.EpochInfo) bool { return true })
+		if !found {
+			return nil
+		}
+
+		// the proposal passes after its voting period, so the execution is
+		// scheduled a few epochs ahead
+		epochNumber := epoch.CurrentEpoch + int64(simtypes.RandIntBetween(r, 2, 10))
+		msg, err := types.NewMsgScheduleExecution(k.GetAuthority(), epoch.Identifier, epochNumber, []sdk.Msg{updateParams(r, ctx, accs)})
+		if err != nil {
+			panic(err)
+		}
+		return msg
+	}
+}
+
+// SimulateMsgCancelExecution returns a MsgCancelExecution for a random
+// pending scheduled execution
+func SimulateMsgCancelExecution(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		executions := k.AllScheduledExecutions(ctx)
+		if len(executions) == 0 {
+			return nil
+		}
+
+		return &types.MsgCancelExecution{
+			Authority: k.GetAuthority(),
+			Id:        executions[r.Intn(len(executions))].Id,
+		}
+	}
+}
+
+// randomEpochInfo returns a random epoch info matching the filter
+func randomEpochInfo(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, filter func(types.EpochInfo) bool) (types.EpochInfo, bool) {
+	var epochs []types.EpochInfo
+	for _, epoch := range k.AllEpochInfos(ctx) {
+		if filter(epoch) {
+			epochs = append(epochs, epoch)
+		}
+	}
+	if len(epochs) == 0 {
+		return types.EpochInfo{}, false
+	}
+	return epochs[r.Intn(len(epochs))], true
+}
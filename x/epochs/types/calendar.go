@@ -0,0 +1,103 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Validate checks that the alignment is a known calendar alignment
+func (a CalendarAlignment) Validate() error {
+	if _, ok := CalendarAlignment_name[int32(a)]; !ok {
+		return fmt.Errorf("invalid calendar alignment: %d", a)
+	}
+	return nil
+}
+
+// Truncate returns the start of the UTC calendar period containing t
+func (a CalendarAlignment) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch a {
+	case CalendarAlignmentDay:
+		return day
+	case CalendarAlignmentWeek:
+		// time.Weekday starts the week on Sunday
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case CalendarAlignmentMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return t
+	}
+}
+
+// Next returns the start of the UTC calendar period following the one
+// containing t
+func (a CalendarAlignment) Next(t time.Time) time.Time {
+	start := a.Truncate(t)
+
+	switch a {
+	case CalendarAlignmentDay:
+		return start.AddDate(0, 0, 1)
+	case CalendarAlignmentWeek:
+		return start.AddDate(0, 0, 7)
+	case CalendarAlignmentMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return t
+	}
+}
+
+// Periods returns the number of UTC calendar periods that start after the
+// period containing from and no later than to
+func (a CalendarAlignment) Periods(from, to time.Time) int64 {
+	if !to.After(from) {
+		return 0
+	}
+	return a.index(to) - a.index(from)
+}
+
+// index returns the number of the calendar period containing t, counted from
+// the period containing the unix epoch
+func (a CalendarAlignment) index(t time.Time) int64 {
+	t = t.UTC()
+
+	switch a {
+	case CalendarAlignmentDay:
+		return floorDiv(t.Unix(), secondsPerDay)
+	case CalendarAlignmentWeek:
+		// the unix epoch is a Thursday, the first Monday is 4 days later
+		return floorDiv(floorDiv(t.Unix(), secondsPerDay)-4, 7)
+	case CalendarAlignmentMonth:
+		return int64(t.Year()-1970)*12 + int64(t.Month()-time.January)
+	default:
+		return 0
+	}
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
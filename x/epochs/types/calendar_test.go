@@ -0,0 +1,106 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendarAlignment(t *testing.T) {
+	testCases := []struct {
+		name        string
+		alignment   CalendarAlignment
+		t           time.Time
+		expTruncate time.Time
+		expNext     time.Time
+	}{
+		{
+			name:        "day",
+			alignment:   CalendarAlignmentDay,
+			t:           time.Date(2024, 2, 28, 23, 59, 59, 0, time.UTC),
+			expTruncate: time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC),
+			expNext:     time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "day in another time zone",
+			alignment:   CalendarAlignmentDay,
+			t:           time.Date(2024, 3, 1, 1, 0, 0, 0, time.FixedZone("CET", 3600*2)),
+			expTruncate: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			expNext:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "week on a sunday",
+			alignment:   CalendarAlignmentWeek,
+			t:           time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC),
+			expTruncate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expNext:     time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "week on a monday",
+			alignment:   CalendarAlignmentWeek,
+			t:           time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			expTruncate: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+			expNext:     time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "month across the year end",
+			alignment:   CalendarAlignmentMonth,
+			t:           time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC),
+			expTruncate: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+			expNext:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "leap february",
+			alignment:   CalendarAlignmentMonth,
+			t:           time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+			expTruncate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			expNext:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expTruncate, tc.alignment.Truncate(tc.t))
+			require.Equal(t, tc.expNext, tc.alignment.Next(tc.t))
+		})
+	}
+}
+
+func TestCalendarPeriods(t *testing.T) {
+	from := time.Date(2023, 11, 29, 15, 30, 0, 0, time.UTC)
+	for _, alignment := range []CalendarAlignment{CalendarAlignmentDay, CalendarAlignmentWeek, CalendarAlignmentMonth} {
+		// count the period starts one by one
+		var expected int64
+		next := alignment.Next(from)
+		for to := from; to.Before(from.AddDate(1, 0, 0)); to = to.Add(7 * time.Hour) {
+			for !next.After(to) {
+				expected++
+				next = alignment.Next(next)
+			}
+			require.Equal(t, expected, alignment.Periods(from, to), "%s to %s", alignment, to)
+		}
+	}
+
+	day := CalendarAlignmentDay
+	require.Equal(t, int64(0), day.Periods(from, from.Add(-time.Hour)))
+	require.Equal(t, int64(1), day.Periods(from, time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC)))
+
+	// the count does not depend on the distance between both times
+	require.Equal(t, int64(738885), day.Periods(time.Time{}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.Equal(t, int64(24276), CalendarAlignmentMonth.Periods(time.Time{}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
@@ -31,6 +31,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(Params{}, "galactica/x/epochs/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "galactica/x/epochs/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateEpochInfo{}, "galactica/x/epochs/MsgCreateEpochInfo")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEpochDuration{}, "galactica/x/epochs/MsgUpdateDuration")
+	legacy.RegisterAminoMsg(cdc, &MsgDeleteEpochInfo{}, "galactica/x/epochs/MsgDeleteEpochInfo")
+	legacy.RegisterAminoMsg(cdc, &MsgPauseEpoch{}, "galactica/x/epochs/MsgPauseEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgResumeEpoch{}, "galactica/x/epochs/MsgResumeEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleExecution{}, "galactica/x/epochs/MsgScheduleExecution")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelExecution{}, "galactica/x/epochs/MsgCancelExecution")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -38,6 +45,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgCreateEpochInfo{},
+		&MsgUpdateEpochDuration{},
+		&MsgDeleteEpochInfo{},
+		&MsgPauseEpoch{},
+		&MsgResumeEpoch{},
+		&MsgScheduleExecution{},
+		&MsgCancelExecution{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
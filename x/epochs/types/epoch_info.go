@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // StartInitialEpoch sets the epoch info fields to their start values
@@ -27,12 +28,85 @@ func (ei *EpochInfo) StartInitialEpoch() {
 	ei.EpochCountingStarted = true
 	ei.CurrentEpoch = 1
 	ei.CurrentEpochStartTime = ei.StartTime
+	ei.Stats = EpochStats{}
+	if ei.IsCalendarAligned() {
+		ei.CurrentEpochStartTime = ei.Alignment.Truncate(ei.StartTime)
+	}
 }
 
-// EndEpoch increments the epoch counter and resets the epoch start time
+// EndEpoch increments the epoch counter and resets the epoch start time and
+// stats
 func (ei *EpochInfo) EndEpoch() {
 	ei.CurrentEpoch++
-	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(ei.Duration)
+	ei.CurrentEpochStartTime = ei.EpochEndTime()
+	ei.Stats = EpochStats{}
+}
+
+// IsRunning returns true if the epoch counting has started and the epoch is
+// not paused
+func (ei EpochInfo) IsRunning() bool {
+	return ei.EpochCountingStarted && !ei.Paused
+}
+
+// TrackBlock adds a block with the given number of transactions and gas used
+// to the stats
+func (s *EpochStats) TrackBlock(txCount, gasUsed uint64) {
+	s.TxCount += txCount
+	s.GasUsed += gasUsed
+	s.BlockCount++
+}
+
+// EpochEndTime returns the scheduled end time of the current epoch. For
+// block-based epochs the current epoch start time is returned.
+func (ei EpochInfo) EpochEndTime() time.Time {
+	if ei.IsCalendarAligned() {
+		return ei.Alignment.Next(ei.CurrentEpochStartTime)
+	}
+	return ei.CurrentEpochStartTime.Add(ei.Duration)
+}
+
+// IsCalendarAligned returns true if the epoch boundaries are aligned to the
+// UTC calendar
+func (ei EpochInfo) IsCalendarAligned() bool {
+	return ei.Alignment != CalendarAlignmentNone
+}
+
+// IsBlockBased returns true if the epoch length is defined in blocks instead
+// of wall-clock time
+func (ei EpochInfo) IsBlockBased() bool {
+	return ei.DurationBlocks > 0
+}
+
+// MissedEpochs returns the number of epochs that are over at the given block
+// height and time, starting with the current epoch. For time-based epochs an
+// epoch is over once the block time is strictly after its end time.
+func (ei EpochInfo) MissedEpochs(blockHeight int64, blockTime time.Time) int64 {
+	if ei.IsBlockBased() {
+		return (blockHeight - ei.CurrentEpochStartHeight) / ei.DurationBlocks
+	}
+	if ei.IsCalendarAligned() {
+		// an epoch is over once the block time is strictly after the start of
+		// the following calendar period
+		return ei.Alignment.Periods(ei.CurrentEpochStartTime, blockTime.Add(-time.Nanosecond))
+	}
+	elapsed := blockTime.Sub(ei.CurrentEpochStartTime)
+	if ei.Duration <= 0 || elapsed <= ei.Duration {
+		return 0
+	}
+	return int64((elapsed - 1) / ei.Duration)
+}
+
+// SkipEpochs advances the epoch counter and the epoch start time by the given
+// number of epochs
+func (ei *EpochInfo) SkipEpochs(n int64) {
+	if ei.IsCalendarAligned() {
+		for i := int64(0); i < n; i++ {
+			ei.EndEpoch()
+		}
+		return
+	}
+	ei.CurrentEpoch += n
+	ei.CurrentEpochStartTime = ei.CurrentEpochStartTime.Add(time.Duration(n) * ei.Duration)
 }
 
 // Validate performs a stateless validation of the epoch info fields
@@ -40,14 +114,81 @@ func (ei EpochInfo) Validate() error {
 	if strings.TrimSpace(ei.Identifier) == "" {
 		return errors.New("epoch identifier cannot be blank")
 	}
-	if ei.Duration == 0 {
-		return errors.New("epoch duration cannot be 0")
+	if ei.DurationBlocks < 0 {
+		return fmt.Errorf("epoch duration blocks cannot be negative: %d", ei.DurationBlocks)
+	}
+	if ei.Duration < 0 {
+		return fmt.Errorf("epoch duration cannot be negative: %s", ei.Duration)
+	}
+	if err := ei.Alignment.Validate(); err != nil {
+		return err
+	}
+	if ei.IsCalendarAligned() {
+		if ei.Duration != 0 || ei.DurationBlocks != 0 {
+			return errors.New("calendar aligned epochs cannot set a duration or duration blocks")
+		}
+	} else {
+		if ei.Duration == 0 && ei.DurationBlocks == 0 {
+			return errors.New("epoch duration cannot be 0")
+		}
+		if ei.Duration != 0 && ei.DurationBlocks != 0 {
+			return errors.New("epoch duration and duration blocks cannot both be set")
+		}
 	}
 	if ei.CurrentEpoch < 0 {
-		return fmt.Errorf("current epoch cannot be negative: %d", ei.CurrentEpochStartHeight)
+		return fmt.Errorf("current epoch cannot be negative: %d", ei.CurrentEpoch)
 	}
 	if ei.CurrentEpochStartHeight < 0 {
 		return fmt.Errorf("current epoch start height cannot be negative: %d", ei.CurrentEpochStartHeight)
 	}
 	return nil
 }
+
+// Validate performs a stateless validation of the epoch record fields
+func (r EpochRecord) Validate() error {
+	if err := ValidateEpochIdentifierString(r.Identifier); err != nil {
+		return err
+	}
+	if r.EpochNumber <= 0 {
+		return fmt.Errorf("epoch record number must be positive: %d", r.EpochNumber)
+	}
+	if r.StartHeight < 0 || r.EndHeight < r.StartHeight {
+		return fmt.Errorf("invalid epoch record height range: %d-%d", r.StartHeight, r.EndHeight)
+	}
+	if r.EndTime.Before(r.StartTime) {
+		return fmt.Errorf("epoch record end time %s before start time %s", r.EndTime, r.StartTime)
+	}
+	return nil
+}
+
+// StartingEpochContext returns the context of the current epoch as passed to
+// BeforeEpochStart hooks
+func (ei EpochInfo) StartingEpochContext() EpochContext {
+	epoch := EpochContext{
+		Identifier:  ei.Identifier,
+		EpochNumber: ei.CurrentEpoch,
+		StartTime:   ei.CurrentEpochStartTime,
+		StartHeight: ei.CurrentEpochStartHeight,
+	}
+	if ei.IsBlockBased() {
+		epoch.EndHeight = ei.CurrentEpochStartHeight + ei.DurationBlocks
+	} else {
+		epoch.EndTime = ei.EpochEndTime()
+	}
+	return epoch
+}
+
+// EpochContext returns the context of the recorded epoch as passed to
+// AfterEpochEnd hooks
+func (r EpochRecord) EpochContext() EpochContext {
+	return EpochContext{
+		Identifier:  r.Identifier,
+		EpochNumber: r.EpochNumber,
+		StartTime:   r.StartTime,
+		EndTime:     r.EndTime,
+		StartHeight: r.StartHeight,
+		EndHeight:   r.EndHeight,
+		Blocks:      r.EndHeight - r.StartHeight,
+		Stats:       r.Stats,
+	}
+}
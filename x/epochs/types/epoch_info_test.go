@@ -44,6 +44,39 @@ func (suite *EpochInfoTestSuite) TestStartEndEpoch() {
 	suite.Require().Equal(startTime.Add(duration), ei.CurrentEpochStartTime)
 }
 
+func (suite *EpochInfoTestSuite) TestMissedEpochs() {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeBased := EpochInfo{CurrentEpochStartTime: startTime, CurrentEpochStartHeight: 10, Duration: time.Hour}
+	blockBased := EpochInfo{CurrentEpochStartTime: startTime, CurrentEpochStartHeight: 10, DurationBlocks: 5}
+
+	suite.Require().Equal(int64(0), timeBased.MissedEpochs(100, startTime.Add(time.Hour)))
+	suite.Require().Equal(int64(1), timeBased.MissedEpochs(100, startTime.Add(time.Hour+time.Second)))
+	suite.Require().Equal(int64(2), timeBased.MissedEpochs(100, startTime.Add(3*time.Hour)))
+
+	suite.Require().Equal(int64(0), blockBased.MissedEpochs(14, startTime.Add(24*time.Hour)))
+	suite.Require().Equal(int64(1), blockBased.MissedEpochs(15, startTime))
+	suite.Require().Equal(int64(2), blockBased.MissedEpochs(20, startTime))
+}
+
+func (suite *EpochInfoTestSuite) TestCalendarAlignedEpochs() {
+	// a Wednesday afternoon
+	startTime := time.Date(2024, 1, 31, 15, 30, 0, 0, time.UTC)
+	ei := EpochInfo{StartTime: startTime, Alignment: CalendarAlignmentMonth}
+
+	// the first epoch covers the whole calendar period containing the start
+	ei.StartInitialEpoch()
+	suite.Require().Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), ei.CurrentEpochStartTime)
+	suite.Require().Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), ei.EpochEndTime())
+
+	suite.Require().Equal(int64(0), ei.MissedEpochs(0, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+	suite.Require().Equal(int64(2), ei.MissedEpochs(0, time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)))
+
+	ei.SkipEpochs(2)
+	suite.Require().Equal(int64(3), ei.CurrentEpoch)
+	suite.Require().Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), ei.CurrentEpochStartTime)
+	suite.Require().Equal(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), ei.EpochEndTime())
+}
+
 func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 	testCases := []struct {
 		name       string
@@ -60,6 +93,10 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
 			},
 			false,
 		},
@@ -73,6 +110,10 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
 			},
 			false,
 		},
@@ -86,6 +127,10 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
 			},
 			false,
 		},
@@ -99,9 +144,115 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				-1,
+				0,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
+			},
+			false,
+		},
+		{
+			"invalid - negative duration blocks",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				-1,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
+			},
+			false,
+		},
+		{
+			"invalid - both duration and duration blocks",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24,
+				1,
+				time.Now(),
+				true,
+				1,
+				100,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
+			},
+			false,
+		},
+		{
+			"pass - block based",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				100,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
+			},
+			true,
+		},
+		{
+			"invalid - calendar aligned with duration",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				time.Hour * 24 * 7,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CalendarAlignmentWeek,
+				EpochStats{},
 			},
 			false,
 		},
+		{
+			"invalid - unknown calendar alignment",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CalendarAlignment(9),
+				EpochStats{},
+			},
+			false,
+		},
+		{
+			"pass - calendar aligned",
+			EpochInfo{
+				WeekEpochID,
+				time.Now(),
+				0,
+				1,
+				time.Now(),
+				true,
+				1,
+				0,
+				false,
+				CalendarAlignmentWeek,
+				EpochStats{},
+			},
+			true,
+		},
 		{
 			"pass",
 			EpochInfo{
@@ -112,6 +263,10 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 				time.Now(),
 				true,
 				1,
+				0,
+				false,
+				CalendarAlignmentNone,
+				EpochStats{},
 			},
 			true,
 		},
@@ -127,3 +282,15 @@ func (suite *EpochInfoTestSuite) TestValidateEpochInfo() {
 		}
 	}
 }
+
+func (suite *EpochInfoTestSuite) TestValidateNegativeCurrentEpoch() {
+	ei := EpochInfo{
+		Identifier:              WeekEpochID,
+		Duration:                time.Hour * 24,
+		CurrentEpoch:            -2,
+		CurrentEpochStartHeight: 7,
+	}
+
+	err := ei.Validate()
+	suite.Require().EqualError(err, "current epoch cannot be negative: -2")
+}
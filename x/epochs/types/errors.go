@@ -24,6 +24,15 @@ import (
 
 // x/epochs module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner     = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample            = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrEpochInfoNotFound = sdkerrors.Register(ModuleName, 1102, "epoch info not found")
+	ErrEpochInfoExists   = sdkerrors.Register(ModuleName, 1103, "epoch info already exists")
+	ErrEpochPaused       = sdkerrors.Register(ModuleName, 1104, "epoch is paused")
+	ErrEpochNotPaused    = sdkerrors.Register(ModuleName, 1105, "epoch is not paused")
+
+	ErrScheduledExecutionNotFound = sdkerrors.Register(ModuleName, 1106, "scheduled execution not found")
+	ErrInvalidScheduledExecution  = sdkerrors.Register(ModuleName, 1107, "invalid scheduled execution")
+
+	ErrEpochInUse = sdkerrors.Register(ModuleName, 1108, "epoch is in use")
 )
@@ -18,9 +18,45 @@ package types
 
 // epochs events
 const (
-	EventTypeEpochEnd   = "epoch_end"
-	EventTypeEpochStart = "epoch_start"
+	EventTypeEpochEnd            = "epoch_end"
+	EventTypeEpochStart          = "epoch_start"
+	EventTypeEpochSkipped        = "epoch_skipped"
+	EventTypeEpochHookFailed     = "epoch_hook_failed"
+	EventTypePauseEpoch          = "pause_epoch"
+	EventTypeResumeEpoch         = "resume_epoch"
+	EventTypeCreateEpochInfo     = "create_epoch_info"
+	EventTypeUpdateEpochDuration = "update_epoch_duration"
+	EventTypeDeleteEpochInfo     = "delete_epoch_info"
+	EventTypeScheduleExecution   = "schedule_execution"
+	EventTypeCancelExecution     = "cancel_execution"
+	EventTypeScheduledExecution  = "scheduled_execution"
 
-	AttributeEpochNumber    = "epoch_number"
-	AttributeEpochStartTime = "start_time"
+	AttributeEpochNumber         = "epoch_number"
+	AttributeEpochStartTime      = "start_time"
+	AttributeEpochIdentifier     = "identifier"
+	AttributeEpochDuration       = "duration"
+	AttributeSkippedEpochs       = "skipped_epochs"
+	AttributeEpochDurationBlocks = "duration_blocks"
+	AttributeHookModule          = "module"
+	AttributeHook                = "hook"
+	AttributeError               = "error"
+	AttributeReanchor            = "reanchor"
+	AttributeEpochStartHeight    = "start_height"
+	AttributeEpochAlignment      = "alignment"
+	AttributeTxCount             = "tx_count"
+	AttributeGasUsed             = "gas_used"
+	AttributeBlockCount          = "block_count"
+
+	AttributeScheduledExecutionID = "scheduled_execution_id"
+	AttributeResult               = "result"
+
+	AttributeValueSuccess = "success"
+	AttributeValueFailure = "failure"
+)
+
+// epoch hook names used in the epoch_hook_failed event
+const (
+	HookAfterEpochEnd     = "after_epoch_end"
+	HookBeforeEpochStart  = "before_epoch_start"
+	HookAfterEpochCatchUp = "after_epoch_catch_up"
 )
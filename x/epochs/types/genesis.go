@@ -19,6 +19,8 @@ package types
 import (
 	"fmt"
 	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	// this line is used by starport scaffolding # genesis/types/import
 )
 
@@ -57,6 +59,9 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 
 	epochIdentifiers := make(map[string]bool)
 
@@ -70,5 +75,46 @@ func (gs GenesisState) Validate() error {
 		epochIdentifiers[epoch.Identifier] = true
 	}
 
+	records := make(map[string]bool)
+
+	for _, record := range gs.History {
+		key := string(EpochHistoryKey(record.Identifier, record.EpochNumber))
+		if records[key] {
+			return fmt.Errorf("duplicated epoch record %s %d", record.Identifier, record.EpochNumber)
+		}
+		if err := record.Validate(); err != nil {
+			return err
+		}
+		records[key] = true
+	}
+
+	executions := make(map[uint64]bool)
+
+	for _, execution := range gs.ScheduledExecutions {
+		if executions[execution.Id] {
+			return fmt.Errorf("duplicated scheduled execution %d", execution.Id)
+		}
+		if err := execution.Validate(); err != nil {
+			return err
+		}
+		if !epochIdentifiers[execution.Identifier] {
+			return fmt.Errorf("scheduled execution %d for unknown epoch %s", execution.Id, execution.Identifier)
+		}
+		if gs.NextScheduledExecutionId != 0 && execution.Id >= gs.NextScheduledExecutionId {
+			return fmt.Errorf("scheduled execution %d is not before the next id %d", execution.Id, gs.NextScheduledExecutionId)
+		}
+		executions[execution.Id] = true
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfacesMessage interface
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, execution := range gs.ScheduledExecutions {
+		if err := execution.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,6 +31,44 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CalendarAlignment defines UTC calendar boundaries epochs can be aligned to.
+type CalendarAlignment int32
+
+const (
+	// CALENDAR_ALIGNMENT_NONE epochs last for a fixed duration or number of
+	// blocks.
+	CalendarAlignmentNone CalendarAlignment = 0
+	// CALENDAR_ALIGNMENT_DAY epochs end at 00:00 UTC.
+	CalendarAlignmentDay CalendarAlignment = 1
+	// CALENDAR_ALIGNMENT_WEEK epochs end on Mondays at 00:00 UTC.
+	CalendarAlignmentWeek CalendarAlignment = 2
+	// CALENDAR_ALIGNMENT_MONTH epochs end on the first day of a month at
+	// 00:00 UTC.
+	CalendarAlignmentMonth CalendarAlignment = 3
+)
+
+var CalendarAlignment_name = map[int32]string{
+	0: "CALENDAR_ALIGNMENT_NONE",
+	1: "CALENDAR_ALIGNMENT_DAY",
+	2: "CALENDAR_ALIGNMENT_WEEK",
+	3: "CALENDAR_ALIGNMENT_MONTH",
+}
+
+var CalendarAlignment_value = map[string]int32{
+	"CALENDAR_ALIGNMENT_NONE":  0,
+	"CALENDAR_ALIGNMENT_DAY":   1,
+	"CALENDAR_ALIGNMENT_WEEK":  2,
+	"CALENDAR_ALIGNMENT_MONTH": 3,
+}
+
+func (x CalendarAlignment) String() string {
+	return proto.EnumName(CalendarAlignment_name, int32(x))
+}
+
+func (CalendarAlignment) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3afea5a9077d334b, []int{0}
+}
+
 // EpochInfo defines the message interface containing the relevant informations about
 // an epoch.
 type EpochInfo struct {
@@ -46,6 +86,18 @@ type EpochInfo struct {
 	EpochCountingStarted bool `protobuf:"varint,6,opt,name=epoch_counting_started,json=epochCountingStarted,proto3" json:"epoch_counting_started,omitempty"`
 	// current_epoch_start_height of the epoch
 	CurrentEpochStartHeight int64 `protobuf:"varint,7,opt,name=current_epoch_start_height,json=currentEpochStartHeight,proto3" json:"current_epoch_start_height,omitempty"`
+	// duration_blocks is the length of the epoch in blocks. When set, the epoch
+	// ends after the given number of blocks and duration must be zero.
+	DurationBlocks int64 `protobuf:"varint,8,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty" yaml:"duration_blocks"`
+	// paused epochs are skipped by the BeginBlocker until they are resumed
+	Paused bool `protobuf:"varint,9,opt,name=paused,proto3" json:"paused,omitempty"`
+	// alignment aligns the epoch boundaries to the UTC calendar. When set,
+	// duration and duration_blocks must be zero and the first epoch starts at
+	// the beginning of the calendar period containing start_time.
+	Alignment CalendarAlignment `protobuf:"varint,10,opt,name=alignment,proto3,enum=galactica.epochs.CalendarAlignment" json:"alignment,omitempty"`
+	// stats of the current epoch, accumulated at the end of every block while
+	// the epoch is running
+	Stats EpochStats `protobuf:"bytes,11,opt,name=stats,proto3" json:"stats"`
 }
 
 func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
@@ -130,19 +182,295 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+func (m *EpochInfo) GetDurationBlocks() int64 {
+	if m != nil {
+		return m.DurationBlocks
+	}
+	return 0
+}
+
+func (m *EpochInfo) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *EpochInfo) GetAlignment() CalendarAlignment {
+	if m != nil {
+		return m.Alignment
+	}
+	return CalendarAlignmentNone
+}
+
+func (m *EpochInfo) GetStats() EpochStats {
+	if m != nil {
+		return m.Stats
+	}
+	return EpochStats{}
+}
+
+// EpochStats are the chain activity statistics of an epoch
+type EpochStats struct {
+	// tx_count is the number of transactions included in the epoch's blocks
+	TxCount uint64 `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// gas_used is the total gas used by the transactions of the epoch
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// block_count is the number of blocks tracked for the epoch, which excludes
+	// blocks produced while the epoch was paused
+	BlockCount uint64 `protobuf:"varint,3,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+}
+
+func (m *EpochStats) Reset()         { *m = EpochStats{} }
+func (m *EpochStats) String() string { return proto.CompactTextString(m) }
+func (*EpochStats) ProtoMessage()    {}
+func (*EpochStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3afea5a9077d334b, []int{1}
+}
+func (m *EpochStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochStats.Merge(m, src)
+}
+func (m *EpochStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochStats proto.InternalMessageInfo
+
+func (m *EpochStats) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *EpochStats) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EpochStats) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+// EpochRecord is the record of a completed epoch. The epoch spans the blocks
+// from start_height up to, but excluding, end_height, the height of the block
+// that ended it.
+type EpochRecord struct {
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// epoch_number of the completed epoch
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// start_time of the epoch
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time of the epoch, which is the start time of the following epoch
+	EndTime time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// start_height is the height of the block that started the epoch
+	StartHeight int64 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the height of the block that ended the epoch
+	EndHeight int64 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// stats of the epoch at the time it ended
+	Stats EpochStats `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *EpochRecord) Reset()         { *m = EpochRecord{} }
+func (m *EpochRecord) String() string { return proto.CompactTextString(m) }
+func (*EpochRecord) ProtoMessage()    {}
+func (*EpochRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3afea5a9077d334b, []int{2}
+}
+func (m *EpochRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRecord.Merge(m, src)
+}
+func (m *EpochRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRecord proto.InternalMessageInfo
+
+func (m *EpochRecord) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EpochRecord) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochRecord) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *EpochRecord) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetStats() EpochStats {
+	if m != nil {
+		return m.Stats
+	}
+	return EpochStats{}
+}
+
+// ScheduledExecution is a set of authority-signed messages executed at the
+// start of an epoch
+type ScheduledExecution struct {
+	// id of the scheduled execution
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// identifier of the epoch
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// epoch_number of the epoch at whose start the messages are executed
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// messages executed atomically through the message router
+	Messages []*types.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ScheduledExecution) Reset()         { *m = ScheduledExecution{} }
+func (m *ScheduledExecution) String() string { return proto.CompactTextString(m) }
+func (*ScheduledExecution) ProtoMessage()    {}
+func (*ScheduledExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3afea5a9077d334b, []int{3}
+}
+func (m *ScheduledExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledExecution.Merge(m, src)
+}
+func (m *ScheduledExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledExecution proto.InternalMessageInfo
+
+func (m *ScheduledExecution) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *ScheduledExecution) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *ScheduledExecution) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// epochs is a slice of EpochInfo that defines the epochs in the genesis state
 	Epochs []EpochInfo `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+	// history is a slice of the records of completed epochs
+	History []EpochRecord `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+	// scheduled_executions is a slice of the pending scheduled executions
+	ScheduledExecutions []ScheduledExecution `protobuf:"bytes,4,rep,name=scheduled_executions,json=scheduledExecutions,proto3" json:"scheduled_executions"`
+	// next_scheduled_execution_id is the id of the next scheduled execution.
+	// When zero it follows the highest id of the scheduled executions.
+	NextScheduledExecutionId uint64 `protobuf:"varint,5,opt,name=next_scheduled_execution_id,json=nextScheduledExecutionId,proto3" json:"next_scheduled_execution_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3afea5a9077d334b, []int{1}
+	return fileDescriptor_3afea5a9077d334b, []int{4}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,48 +513,105 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetHistory() []EpochRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledExecutions() []ScheduledExecution {
+	if m != nil {
+		return m.ScheduledExecutions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduledExecutionId() uint64 {
+	if m != nil {
+		return m.NextScheduledExecutionId
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("galactica.epochs.CalendarAlignment", CalendarAlignment_name, CalendarAlignment_value)
 	proto.RegisterType((*EpochInfo)(nil), "galactica.epochs.EpochInfo")
+	proto.RegisterType((*EpochStats)(nil), "galactica.epochs.EpochStats")
+	proto.RegisterType((*EpochRecord)(nil), "galactica.epochs.EpochRecord")
+	proto.RegisterType((*ScheduledExecution)(nil), "galactica.epochs.ScheduledExecution")
 	proto.RegisterType((*GenesisState)(nil), "galactica.epochs.GenesisState")
 }
 
 func init() { proto.RegisterFile("galactica/epochs/genesis.proto", fileDescriptor_3afea5a9077d334b) }
 
 var fileDescriptor_3afea5a9077d334b = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x35, 0x21, 0x34, 0x97, 0x22, 0xe8, 0xa9, 0x80, 0x09, 0xaa, 0x6d, 0x99, 0xc5, 0xe2,
-	0x8f, 0x2d, 0x02, 0x0b, 0x74, 0x33, 0xa0, 0x82, 0xc4, 0x80, 0x1c, 0x06, 0xc4, 0x12, 0x5d, 0x9c,
-	0xcb, 0xf9, 0xa4, 0xd8, 0x67, 0xd9, 0x67, 0x89, 0x6c, 0x4c, 0x0c, 0x4c, 0x1d, 0xf9, 0x08, 0x8c,
-	0x7c, 0x8c, 0x8e, 0x1d, 0x99, 0x02, 0x4a, 0x06, 0x24, 0xc6, 0x7e, 0x02, 0xe4, 0xbb, 0x73, 0x1a,
-	0x1a, 0x50, 0x97, 0xe8, 0xf2, 0x7e, 0xff, 0xee, 0x3d, 0xbf, 0x83, 0x26, 0xc5, 0x53, 0x1c, 0x09,
-	0x16, 0x61, 0x9f, 0x64, 0x3c, 0x8a, 0x0b, 0x9f, 0x92, 0x94, 0x14, 0xac, 0xf0, 0xb2, 0x9c, 0x0b,
-	0x8e, 0xae, 0xad, 0x70, 0x4f, 0xe1, 0xbd, 0x5d, 0x9c, 0xb0, 0x94, 0xfb, 0xf2, 0x57, 0x91, 0x7a,
-	0x7b, 0x94, 0x53, 0x2e, 0x8f, 0x7e, 0x75, 0xd2, 0xd5, 0xfd, 0x0d, 0xeb, 0x0c, 0xe7, 0x38, 0xd1,
-	0xce, 0x3d, 0x93, 0x72, 0x4e, 0xa7, 0xc4, 0x97, 0xff, 0x46, 0xe5, 0xc4, 0x1f, 0x97, 0x39, 0x16,
-	0x8c, 0xa7, 0x1a, 0xb7, 0xce, 0xe3, 0x82, 0x25, 0xa4, 0x10, 0x38, 0xc9, 0x14, 0xc1, 0xf9, 0xdc,
-	0x82, 0x9d, 0x17, 0x95, 0xf1, 0xab, 0x74, 0xc2, 0x91, 0x09, 0x21, 0x1b, 0x93, 0x54, 0xb0, 0x09,
-	0x23, 0xb9, 0x01, 0x6c, 0xe0, 0x76, 0xc2, 0xb5, 0x0a, 0x7a, 0x07, 0x61, 0x21, 0x70, 0x2e, 0x86,
-	0x95, 0x8d, 0xb1, 0x65, 0x03, 0xb7, 0xdb, 0xef, 0x79, 0x2a, 0xc3, 0xab, 0x33, 0xbc, 0xb7, 0x75,
-	0x46, 0xb0, 0x7f, 0x3c, 0xb7, 0x1a, 0xa7, 0x73, 0x6b, 0x77, 0x86, 0x93, 0xe9, 0x53, 0xe7, 0x4c,
-	0xeb, 0x1c, 0xfd, 0xb0, 0x40, 0xd8, 0x91, 0x85, 0x8a, 0x8e, 0x62, 0xb8, 0x5d, 0x5f, 0xdd, 0x68,
-	0x4a, 0xdf, 0x5b, 0x1b, 0xbe, 0xcf, 0x35, 0x21, 0x78, 0x58, 0xd9, 0xfe, 0x9e, 0x5b, 0xa8, 0x96,
-	0xdc, 0xe7, 0x09, 0x13, 0x24, 0xc9, 0xc4, 0xec, 0x74, 0x6e, 0x5d, 0x55, 0x61, 0x35, 0xe6, 0x7c,
-	0xa9, 0xa2, 0x56, 0xee, 0xe8, 0x0e, 0xbc, 0x12, 0x95, 0x79, 0x4e, 0x52, 0x31, 0x94, 0x13, 0x35,
-	0x5a, 0x36, 0x70, 0x9b, 0xe1, 0x8e, 0x2e, 0xca, 0x61, 0xa0, 0x8f, 0x00, 0x1a, 0x7f, 0xb1, 0x86,
-	0x6b, 0x7d, 0x5f, 0xba, 0xb0, 0xef, 0x7b, 0xba, 0x6f, 0x4b, 0x5d, 0xe5, 0x7f, 0x4e, 0x6a, 0x0a,
-	0xd7, 0xd7, 0x93, 0x07, 0xab, 0x89, 0x3c, 0x86, 0x37, 0x14, 0x3f, 0xe2, 0x65, 0x2a, 0x58, 0x4a,
-	0x95, 0x90, 0x8c, 0x8d, 0xb6, 0x0d, 0xdc, 0xed, 0x70, 0x4f, 0xa2, 0xcf, 0x34, 0x38, 0x50, 0x18,
-	0x3a, 0x80, 0xbd, 0x7f, 0xa5, 0xc5, 0x84, 0xd1, 0x58, 0x18, 0x97, 0x65, 0xab, 0x37, 0x37, 0x02,
-	0x5f, 0x4a, 0xd8, 0xf9, 0x04, 0xe0, 0xce, 0xa1, 0xda, 0xdc, 0x81, 0xc0, 0x82, 0xa0, 0x03, 0xd8,
-	0x56, 0xeb, 0x26, 0x77, 0xa1, 0xdb, 0x37, 0xbc, 0xf3, 0x9b, 0xec, 0xbd, 0x91, 0x78, 0xd0, 0xa9,
-	0x3a, 0xfe, 0xfa, 0xeb, 0xdb, 0x5d, 0x10, 0x6a, 0x09, 0x7a, 0x02, 0xdb, 0x8a, 0x63, 0x6c, 0xd9,
-	0x4d, 0xb7, 0xdb, 0xbf, 0xbd, 0x29, 0x5e, 0x6d, 0x5e, 0xd0, 0xaa, 0xf4, 0xa1, 0x16, 0x04, 0xaf,
-	0x8f, 0x17, 0x26, 0x38, 0x59, 0x98, 0xe0, 0xe7, 0xc2, 0x04, 0x47, 0x4b, 0xb3, 0x71, 0xb2, 0x34,
-	0x1b, 0xdf, 0x97, 0x66, 0xe3, 0x7d, 0x9f, 0x32, 0x11, 0x97, 0x23, 0x2f, 0xe2, 0x89, 0x7f, 0x58,
-	0xdb, 0x3d, 0x88, 0x78, 0x9e, 0xf9, 0x67, 0x2f, 0xe5, 0x43, 0xfd, 0x56, 0xc4, 0x2c, 0x23, 0xc5,
-	0xa8, 0x2d, 0xbf, 0xd0, 0xa3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xee, 0xe8, 0xdf, 0x8d, 0xa7,
-	0x03, 0x00, 0x00,
+	// 1029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xbf, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0xda, 0x8e, 0x63, 0x8f, 0x43, 0x2e, 0x19, 0x72, 0xc9, 0x66, 0x43, 0xd6, 0x3e, 0x1f,
+	0x85, 0x75, 0x70, 0x6b, 0x25, 0x20, 0x74, 0x70, 0x42, 0xc8, 0x4e, 0xac, 0x5c, 0x44, 0xe2, 0x43,
+	0x9b, 0xa0, 0x03, 0x24, 0xb4, 0x1a, 0xef, 0x4e, 0xd6, 0xab, 0xf3, 0xce, 0x58, 0x3b, 0x63, 0x14,
+	0x77, 0x94, 0x28, 0xd5, 0x95, 0x34, 0xa9, 0x68, 0x28, 0x29, 0x10, 0x7f, 0xc3, 0x89, 0xea, 0x44,
+	0x45, 0x15, 0x50, 0x82, 0x84, 0x44, 0x99, 0x8e, 0xee, 0xb4, 0x33, 0xb3, 0xf9, 0xe1, 0x8d, 0x15,
+	0x5d, 0x13, 0x65, 0xde, 0xf7, 0xbe, 0xf7, 0xcd, 0xfb, 0xde, 0xcc, 0x78, 0x81, 0xe9, 0xa3, 0x3e,
+	0x72, 0x79, 0xe0, 0xa2, 0x06, 0x1e, 0x50, 0xb7, 0xc7, 0x1a, 0x3e, 0x26, 0x98, 0x05, 0xcc, 0x1a,
+	0x44, 0x94, 0x53, 0x38, 0x77, 0x81, 0x5b, 0x12, 0x37, 0xe6, 0x51, 0x18, 0x10, 0xda, 0x10, 0x7f,
+	0x65, 0x92, 0xb1, 0xec, 0x52, 0x16, 0x52, 0xe6, 0x88, 0x55, 0x43, 0x2e, 0x14, 0xb4, 0xe0, 0x53,
+	0x9f, 0xca, 0x78, 0xfc, 0x9f, 0x8a, 0xae, 0xa6, 0x54, 0x07, 0x28, 0x42, 0x61, 0x42, 0x5a, 0xf6,
+	0x29, 0xf5, 0xfb, 0xb8, 0x21, 0x56, 0xdd, 0xe1, 0x41, 0x03, 0x91, 0x91, 0x82, 0xcc, 0x71, 0xc8,
+	0x1b, 0x46, 0x88, 0x07, 0x94, 0x28, 0xbc, 0x32, 0x8e, 0xf3, 0x20, 0xc4, 0x8c, 0xa3, 0x70, 0x20,
+	0x13, 0x6a, 0xff, 0x4c, 0x81, 0x52, 0x3b, 0xd6, 0xdc, 0x26, 0x07, 0x14, 0x9a, 0x00, 0x04, 0x1e,
+	0x26, 0x3c, 0x38, 0x08, 0x70, 0xa4, 0x6b, 0x55, 0xad, 0x5e, 0xb2, 0xaf, 0x44, 0xe0, 0x57, 0x00,
+	0x30, 0x8e, 0x22, 0xee, 0xc4, 0x65, 0xf4, 0x6c, 0x55, 0xab, 0x97, 0xd7, 0x0d, 0x4b, 0x6a, 0x58,
+	0x89, 0x86, 0xb5, 0x9f, 0x68, 0xb4, 0x56, 0x5f, 0x9e, 0x54, 0x32, 0xe7, 0x27, 0x95, 0xf9, 0x11,
+	0x0a, 0xfb, 0x9f, 0xd4, 0x2e, 0xb9, 0xb5, 0x17, 0x7f, 0x55, 0x34, 0xbb, 0x24, 0x02, 0x71, 0x3a,
+	0xec, 0x81, 0x62, 0xb2, 0x75, 0x3d, 0x27, 0xea, 0x2e, 0xa7, 0xea, 0x6e, 0xaa, 0x84, 0xd6, 0x5a,
+	0x5c, 0xf6, 0xbf, 0x93, 0x0a, 0x4c, 0x28, 0xef, 0xd3, 0x30, 0xe0, 0x38, 0x1c, 0xf0, 0xd1, 0xf9,
+	0x49, 0xe5, 0x8e, 0x14, 0x4b, 0xb0, 0xda, 0x8f, 0xb1, 0xd4, 0x45, 0x75, 0x78, 0x1f, 0xbc, 0xe5,
+	0x0e, 0xa3, 0x08, 0x13, 0xee, 0x08, 0xb3, 0xf5, 0x7c, 0x55, 0xab, 0xe7, 0xec, 0x19, 0x15, 0x14,
+	0x66, 0xc0, 0xef, 0x35, 0xa0, 0x5f, 0xcb, 0x72, 0xae, 0xf4, 0x3d, 0x75, 0x6b, 0xdf, 0xef, 0xa9,
+	0xbe, 0x2b, 0x72, 0x2b, 0x93, 0x2a, 0x49, 0x17, 0xee, 0x5e, 0x55, 0xde, 0xbb, 0x70, 0xe4, 0x43,
+	0xb0, 0x28, 0xf3, 0x5d, 0x3a, 0x24, 0x3c, 0x20, 0xbe, 0x24, 0x62, 0x4f, 0x2f, 0x54, 0xb5, 0x7a,
+	0xd1, 0x5e, 0x10, 0xe8, 0x86, 0x02, 0xf7, 0x24, 0x06, 0x1f, 0x03, 0xe3, 0x26, 0xb5, 0x1e, 0x0e,
+	0xfc, 0x1e, 0xd7, 0xa7, 0x45, 0xab, 0x4b, 0x29, 0xc1, 0x27, 0x02, 0x86, 0x1b, 0xe0, 0x4e, 0x62,
+	0x93, 0xd3, 0xed, 0x53, 0xf7, 0x39, 0xd3, 0x8b, 0x31, 0xa3, 0x65, 0x9c, 0x9f, 0x54, 0x16, 0xaf,
+	0xdb, 0xaa, 0x12, 0x6a, 0xf6, 0x6c, 0x12, 0x69, 0x89, 0x00, 0x5c, 0x04, 0x85, 0x01, 0x1a, 0x32,
+	0xec, 0xe9, 0x25, 0xb1, 0x4f, 0xb5, 0x82, 0x4d, 0x50, 0x42, 0xfd, 0xc0, 0x27, 0x21, 0x26, 0x5c,
+	0x07, 0x55, 0xad, 0x3e, 0xbb, 0x7e, 0xdf, 0x1a, 0xbf, 0x4e, 0xd6, 0x06, 0xea, 0x63, 0xe2, 0xa1,
+	0xa8, 0x99, 0xa4, 0xda, 0x97, 0x2c, 0xf8, 0x08, 0x4c, 0x31, 0x8e, 0x38, 0xd3, 0xcb, 0x62, 0x02,
+	0xef, 0xa4, 0xe9, 0x49, 0x4b, 0x9c, 0xb5, 0xf2, 0xf1, 0x0c, 0x6c, 0x49, 0xa8, 0xb9, 0x00, 0x5c,
+	0x42, 0x70, 0x19, 0x14, 0xf9, 0xa1, 0xf4, 0x55, 0x1c, 0xf2, 0xbc, 0x3d, 0xcd, 0x0f, 0x85, 0x93,
+	0x31, 0xe4, 0x23, 0xe6, 0x88, 0xfd, 0x67, 0x25, 0xe4, 0x23, 0xf6, 0x65, 0xdc, 0x40, 0x05, 0x94,
+	0x45, 0xcf, 0x8a, 0x98, 0x13, 0x28, 0x10, 0x21, 0xc1, 0xad, 0xfd, 0x91, 0x05, 0x65, 0xa1, 0x62,
+	0x63, 0x97, 0x46, 0xde, 0xad, 0xb7, 0xe9, 0x1e, 0x98, 0x91, 0x33, 0x22, 0xc3, 0xb0, 0x8b, 0x23,
+	0xa1, 0x97, 0xb3, 0xcb, 0x22, 0xd6, 0x11, 0x21, 0xb8, 0x71, 0xed, 0xc2, 0xe5, 0x6e, 0x3d, 0x78,
+	0xc5, 0xb8, 0xe9, 0xf1, 0xbb, 0xf5, 0x19, 0x28, 0x62, 0xe2, 0xc9, 0x12, 0xf9, 0x37, 0x28, 0x31,
+	0x8d, 0x89, 0x27, 0x0a, 0xdc, 0x03, 0x33, 0xd7, 0x8e, 0xd1, 0x94, 0xdc, 0x28, 0xbb, 0x72, 0x74,
+	0x56, 0x01, 0x88, 0x35, 0x54, 0x42, 0x41, 0x24, 0x94, 0x30, 0xf1, 0x14, 0x7c, 0x31, 0xb9, 0xe9,
+	0x37, 0x9d, 0xdc, 0x6f, 0x1a, 0x80, 0x7b, 0x6e, 0x0f, 0x7b, 0xc3, 0x3e, 0xf6, 0xda, 0x87, 0xd8,
+	0x1d, 0x8a, 0x5b, 0x3c, 0x0b, 0xb2, 0x81, 0xa7, 0x86, 0x97, 0x0d, 0xc6, 0xbd, 0xce, 0xde, 0xea,
+	0x75, 0x2e, 0xed, 0xf5, 0x2e, 0x28, 0x86, 0x98, 0x31, 0xe4, 0x63, 0xa6, 0xe7, 0xab, 0xb9, 0x7a,
+	0x79, 0x7d, 0x21, 0x65, 0x53, 0x93, 0x8c, 0x5a, 0x2b, 0xbf, 0xff, 0xfa, 0x70, 0x49, 0xbd, 0xea,
+	0x5d, 0xc4, 0xb0, 0xf5, 0xdd, 0x5a, 0x17, 0x73, 0xb4, 0x66, 0xed, 0x32, 0xdf, 0xbe, 0x28, 0x51,
+	0x3b, 0xcb, 0x82, 0x99, 0x2d, 0xf9, 0xe3, 0x11, 0xb7, 0x85, 0xe1, 0x63, 0x50, 0x90, 0xcf, 0xba,
+	0xd8, 0x76, 0x79, 0x5d, 0x4f, 0x9b, 0xf0, 0x85, 0xc0, 0x5b, 0xa5, 0xd8, 0x80, 0x9f, 0xff, 0xfd,
+	0xe5, 0x81, 0x66, 0x2b, 0x0a, 0xfc, 0x18, 0x14, 0x64, 0x8e, 0x9e, 0x15, 0x5b, 0x5b, 0x99, 0xe0,
+	0x60, 0xfc, 0x8c, 0x2b, 0x03, 0x15, 0x01, 0x7e, 0x0a, 0xa6, 0x7b, 0x01, 0xe3, 0x34, 0x1a, 0xe9,
+	0x39, 0xc1, 0x5d, 0x9d, 0xc0, 0x95, 0xc7, 0x56, 0xb1, 0x13, 0x0e, 0xfc, 0x16, 0x2c, 0xb0, 0xc4,
+	0x7f, 0x07, 0x27, 0x03, 0x48, 0x2c, 0x7a, 0x37, 0x5d, 0x2b, 0x3d, 0x2d, 0x55, 0xf2, 0x6d, 0x96,
+	0x42, 0xe2, 0xdd, 0xad, 0x10, 0x7c, 0xc8, 0x9d, 0x1b, 0x34, 0x9c, 0xc0, 0x13, 0x47, 0x2d, 0x6f,
+	0xeb, 0x71, 0x4a, 0xba, 0xee, 0xb6, 0xf7, 0xe0, 0x7f, 0x0d, 0xcc, 0xa7, 0xde, 0x0c, 0xf8, 0x11,
+	0x58, 0xda, 0x68, 0xee, 0xb4, 0x3b, 0x9b, 0x4d, 0xdb, 0x69, 0xee, 0x6c, 0x6f, 0x75, 0x76, 0xdb,
+	0x9d, 0x7d, 0xa7, 0xf3, 0xb4, 0xd3, 0x9e, 0xcb, 0x18, 0xcb, 0x47, 0xc7, 0xd5, 0xbb, 0x29, 0x4e,
+	0x87, 0x12, 0xf1, 0xe6, 0xde, 0xc0, 0xdb, 0x6c, 0x7e, 0x3d, 0xa7, 0x19, 0xfa, 0xd1, 0x71, 0x75,
+	0x21, 0x45, 0xdb, 0x44, 0xa3, 0x09, 0x6a, 0xcf, 0xda, 0xed, 0xcf, 0xe7, 0xb2, 0x13, 0xd4, 0x9e,
+	0x61, 0xfc, 0x1c, 0x3e, 0x02, 0xfa, 0x0d, 0xbc, 0xdd, 0xa7, 0x9d, 0xfd, 0x27, 0x73, 0x39, 0xc3,
+	0x38, 0x3a, 0xae, 0x2e, 0xa6, 0x88, 0xbb, 0x94, 0xf0, 0x9e, 0x91, 0xff, 0xe1, 0x27, 0x33, 0xd3,
+	0xda, 0x79, 0x79, 0x6a, 0x6a, 0xaf, 0x4e, 0x4d, 0xed, 0xef, 0x53, 0x53, 0x7b, 0x71, 0x66, 0x66,
+	0x5e, 0x9d, 0x99, 0x99, 0x3f, 0xcf, 0xcc, 0xcc, 0x37, 0xeb, 0x7e, 0xc0, 0x7b, 0xc3, 0xae, 0xe5,
+	0xd2, 0xb0, 0xb1, 0x95, 0xcc, 0xe7, 0xa1, 0x4b, 0xa3, 0x41, 0xe3, 0xf2, 0x53, 0xe3, 0x30, 0xf9,
+	0xd8, 0xe0, 0xa3, 0x01, 0x66, 0xdd, 0x82, 0x38, 0xe4, 0x1f, 0xbc, 0x1e, 0x00, 0xc6, 0xf2, 0x7e,
+	0xaf, 0x03, 0x09, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -249,6 +634,36 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.Alignment != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Alignment))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DurationBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.CurrentEpochStartHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.CurrentEpochStartHeight))
 		i--
@@ -264,12 +679,12 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CurrentEpochStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CurrentEpochStartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if m.CurrentEpoch != 0 {
@@ -277,21 +692,21 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x20
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x1a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
 	dAtA[i] = 0x12
 	if len(m.Identifier) > 0 {
 		i -= len(m.Identifier)
@@ -303,7 +718,7 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *EpochStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -313,51 +728,247 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *EpochStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EpochStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Epochs) > 0 {
-		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.BlockCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x18
 	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.GasUsed != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
+func (m *EpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.EndHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintGenesis(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextScheduledExecutionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduledExecutionId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ScheduledExecutions) > 0 {
+		for iNdEx := len(m.ScheduledExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
 	dAtA[offset] = uint8(v)
 	return base
 }
@@ -386,6 +997,88 @@ func (m *EpochInfo) Size() (n int) {
 	if m.CurrentEpochStartHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.CurrentEpochStartHeight))
 	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.DurationBlocks))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Alignment != 0 {
+		n += 1 + sovGenesis(uint64(m.Alignment))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EpochStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		n += 1 + sovGenesis(uint64(m.TxCount))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGenesis(uint64(m.GasUsed))
+	}
+	if m.BlockCount != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockCount))
+	}
+	return n
+}
+
+func (m *EpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EndHeight))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ScheduledExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGenesis(uint64(m.EpochNumber))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -403,16 +1096,468 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledExecutions) > 0 {
+		for _, e := range m.ScheduledExecutions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduledExecutionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduledExecutionId))
+	}
 	return n
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EpochInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CurrentEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCountingStarted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EpochCountingStarted = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartHeight", wireType)
+			}
+			m.CurrentEpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+			}
+			m.DurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alignment", wireType)
+			}
+			m.Alignment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Alignment |= CalendarAlignment(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EpochInfo) Unmarshal(dAtA []byte) error {
+func (m *EpochRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -435,10 +1580,10 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -474,6 +1619,25 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 			m.Identifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
@@ -506,9 +1670,9 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -535,15 +1699,15 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.CurrentEpoch = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -553,14 +1717,33 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpoch |= int64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -587,15 +1770,65 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CurrentEpochStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochCountingStarted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -605,17 +1838,48 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EpochCountingStarted = bool(v != 0)
-		case 7:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpochStartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
 			}
-			m.CurrentEpochStartHeight = 0
+			m.EpochNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -625,11 +1889,45 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentEpochStartHeight |= int64(b&0x7F) << shift
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -747,6 +2045,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, EpochRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledExecutions = append(m.ScheduledExecutions, ScheduledExecution{})
+			if err := m.ScheduledExecutions[len(m.ScheduledExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledExecutionId", wireType)
+			}
+			m.NextScheduledExecutionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduledExecutionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
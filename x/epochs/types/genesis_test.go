@@ -17,6 +17,7 @@ package types_test
 import (
 	"testing"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Galactica-corp/galactica/x/epochs/types"
@@ -34,13 +35,83 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "invalid catch up policy",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.CatchUpPolicy(5), 1, 0),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max epochs per block",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.CatchUpPolicyCapped, 0, 0),
+			},
+			valid: false,
+		},
+		{
+			desc: "max epochs per block above the limit",
+			genState: &types.GenesisState{
+				Params: types.NewParams(types.CatchUpPolicyCapped, types.MaxEpochsPerBlockLimit+1, 0),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated epoch record",
+			genState: &types.GenesisState{
+				History: []types.EpochRecord{
+					{Identifier: types.DayEpochID, EpochNumber: 1, StartHeight: 1, EndHeight: 2},
+					{Identifier: types.DayEpochID, EpochNumber: 1, StartHeight: 1, EndHeight: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid epoch record height range",
+			genState: &types.GenesisState{
+				History: []types.EpochRecord{
+					{Identifier: types.DayEpochID, EpochNumber: 1, StartHeight: 10, EndHeight: 2},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "scheduled execution of unknown epoch",
+			genState: &types.GenesisState{
+				ScheduledExecutions: []types.ScheduledExecution{
+					{Id: 1, Identifier: types.DayEpochID, EpochNumber: 1, Messages: []*codectypes.Any{{}}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "scheduled execution without messages",
+			genState: &types.GenesisState{
+				Epochs: types.DefaultGenesis().Epochs,
+				ScheduledExecutions: []types.ScheduledExecution{
+					{Id: 1, Identifier: types.DayEpochID, EpochNumber: 1},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "scheduled execution not before the next id",
+			genState: &types.GenesisState{
+				Epochs: types.DefaultGenesis().Epochs,
+				ScheduledExecutions: []types.ScheduledExecution{
+					{Id: 2, Identifier: types.DayEpochID, EpochNumber: 1, Messages: []*codectypes.Any{{}}},
+				},
+				NextScheduledExecutionId: 2,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
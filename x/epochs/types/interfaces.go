@@ -16,12 +16,74 @@
 
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
 
-// EpochHooks event hooks for epoch processing
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EpochHooks event hooks for epoch processing. Returned errors and panics of
+// a hook are isolated by MultiEpochHooks unless the hook is registered as
+// critical. EpochHooks are registered through an adapter to EpochHooksV2.
 type EpochHooks interface {
 	// the first block whose timestamp is after the duration is counted as the end of the epoch
-	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
 	// new epoch is next block of epoch end block
-	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64)
+	BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error
+	// GetModuleName returns the name of the module implementing the hooks
+	GetModuleName() string
+}
+
+// EpochContext describes the epoch passed to EpochHooksV2
+type EpochContext struct {
+	// Identifier of the epoch
+	Identifier string
+	// EpochNumber of the ending or starting epoch
+	EpochNumber int64
+	// StartTime of the epoch
+	StartTime time.Time
+	// EndTime of an ended epoch. For a starting epoch it is the scheduled end
+	// time, which is zero for block-based epochs.
+	EndTime time.Time
+	// StartHeight is the height of the block that started the epoch
+	StartHeight int64
+	// EndHeight is the height of the block that ended the epoch. For a starting
+	// epoch it is the scheduled end height, which is zero for time-based
+	// epochs.
+	EndHeight int64
+	// Blocks is the number of blocks in an ended epoch, zero for a starting
+	// epoch
+	Blocks int64
+	// Stats are the chain activity statistics of an ended epoch, empty for a
+	// starting epoch
+	Stats EpochStats
+}
+
+// EpochHooksV2 event hooks for epoch processing, which receive the full epoch
+// context. Both hooks are called in the same block when an epoch ends and the
+// next one starts, AfterEpochEnd first.
+type EpochHooksV2 interface {
+	// AfterEpochEnd is called with the epoch that has just ended
+	AfterEpochEnd(ctx sdk.Context, epoch EpochContext) error
+	// BeforeEpochStart is called with the epoch that is starting
+	BeforeEpochStart(ctx sdk.Context, epoch EpochContext) error
+	// GetModuleName returns the name of the module implementing the hooks
+	GetModuleName() string
+}
+
+// EpochCatchUpHooks is an optional extension of EpochHooksV2. It is called once
+// per block for an epoch identifier that fell behind by more than one epoch,
+// after the missed epochs have been handled according to the catch up policy.
+type EpochCatchUpHooks interface {
+	// processedEpochs is the number of epochs ended with hooks called in this
+	// block, skippedEpochs the number of epochs jumped over without hooks.
+	AfterEpochCatchUp(ctx sdk.Context, epochIdentifier string, policy CatchUpPolicy, processedEpochs, skippedEpochs int64) error
+}
+
+// EpochDependencyHooks is an optional extension of EpochHooksV2 for hooks that
+// rely on particular epoch identifiers. Epoch infos with one of these
+// identifiers cannot be deleted.
+type EpochDependencyHooks interface {
+	// EpochIdentifiersInUse returns the epoch identifiers the hooks depend on
+	EpochIdentifiersInUse(ctx sdk.Context) []string
 }
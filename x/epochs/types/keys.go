@@ -16,6 +16,11 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "epochs"
@@ -33,11 +38,79 @@ const (
 // prefix bytes for the epochs persistent store
 const (
 	prefixEpoch = iota + 1
+	prefixLastBlockTime
+	prefixAverageBlockTime
+	prefixEpochHistory
+	prefixScheduledExecution
+	prefixScheduledExecutionQueue
+	prefixNextScheduledExecutionID
 )
 
 // KeyPrefixEpoch defines prefix key for storing epochs
 var KeyPrefixEpoch = []byte{prefixEpoch}
 
+// KeyLastBlockTime defines the key for the time of the previous block
+var KeyLastBlockTime = []byte{prefixLastBlockTime}
+
+// KeyAverageBlockTime defines the key for the moving average of block times
+var KeyAverageBlockTime = []byte{prefixAverageBlockTime}
+
+// KeyPrefixEpochHistory defines prefix key for storing completed epoch records
+var KeyPrefixEpochHistory = []byte{prefixEpochHistory}
+
+// EpochHistoryPrefix returns the prefix of the records of the given epoch
+// identifier relative to KeyPrefixEpochHistory
+func EpochHistoryPrefix(identifier string) []byte {
+	return address.MustLengthPrefix([]byte(identifier))
+}
+
+// EpochHistoryKey returns the key of an epoch record relative to
+// KeyPrefixEpochHistory
+func EpochHistoryKey(identifier string, epochNumber int64) []byte {
+	return append(EpochHistoryPrefix(identifier), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// KeyPrefixScheduledExecution defines prefix key for storing scheduled
+// executions by id
+var KeyPrefixScheduledExecution = []byte{prefixScheduledExecution}
+
+// KeyPrefixScheduledExecutionQueue defines prefix key for the index of
+// scheduled executions by epoch identifier and epoch number
+var KeyPrefixScheduledExecutionQueue = []byte{prefixScheduledExecutionQueue}
+
+// KeyNextScheduledExecutionID defines the key for the id of the next
+// scheduled execution
+var KeyNextScheduledExecutionID = []byte{prefixNextScheduledExecutionID}
+
+// ScheduledExecutionKey returns the key of a scheduled execution relative to
+// KeyPrefixScheduledExecution
+func ScheduledExecutionKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// ScheduledExecutionQueuePrefix returns the prefix of the scheduled executions
+// of the given epoch identifier relative to KeyPrefixScheduledExecutionQueue
+func ScheduledExecutionQueuePrefix(identifier string) []byte {
+	return address.MustLengthPrefix([]byte(identifier))
+}
+
+// ScheduledExecutionQueueKey returns the key of a scheduled execution relative
+// to KeyPrefixScheduledExecutionQueue
+func ScheduledExecutionQueueKey(identifier string, epochNumber int64, id uint64) []byte {
+	key := append(ScheduledExecutionQueuePrefix(identifier), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitScheduledExecutionQueueKey returns the epoch number and id of a key
+// relative to the ScheduledExecutionQueuePrefix of its identifier
+func SplitScheduledExecutionQueueKey(key []byte) (epochNumber int64, id uint64) {
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
+
+// BlockTimeWindow is the number of blocks the average block time is smoothed
+// over
+const BlockTimeWindow = 100
+
 var ParamsKey = []byte("p_epochs")
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,57 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgCreateEpochInfo{}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateEpochInfo) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgCreateEpochInfo message.
+func (m *MsgCreateEpochInfo) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateEpochInfo) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.EpochInfo().Validate()
+}
+
+// EpochInfo returns the not yet started epoch info described by the message.
+func (m *MsgCreateEpochInfo) EpochInfo() EpochInfo {
+	return EpochInfo{
+		Identifier:           m.Identifier,
+		StartTime:            m.StartTime,
+		Duration:             m.Duration,
+		DurationBlocks:       m.DurationBlocks,
+		Alignment:            m.Alignment,
+		CurrentEpoch:         0,
+		EpochCountingStarted: false,
+	}
+}
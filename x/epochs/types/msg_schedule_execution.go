@@ -0,0 +1,85 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ sdk.Msg                            = &MsgScheduleExecution{}
+	_ codectypes.UnpackInterfacesMessage = MsgScheduleExecution{}
+)
+
+// NewMsgScheduleExecution creates a MsgScheduleExecution of the given messages
+func NewMsgScheduleExecution(authority, identifier string, epochNumber int64, msgs []sdk.Msg) (*MsgScheduleExecution, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgScheduleExecution{
+		Authority:   authority,
+		Identifier:  identifier,
+		EpochNumber: epochNumber,
+		Messages:    anys,
+	}, nil
+}
+
+// GetMsgs unpacks the messages to schedule
+func (m MsgScheduleExecution) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "scheduled execution")
+}
+
+// UnpackInterfaces implements the UnpackInterfacesMessage interface
+func (m MsgScheduleExecution) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// GetSigners returns the expected signers for a MsgScheduleExecution message.
+func (m *MsgScheduleExecution) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgScheduleExecution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if err := validateExecutionSchedule(m.Identifier, m.EpochNumber, m.Messages); err != nil {
+		return errorsmod.Wrap(ErrInvalidScheduledExecution, err.Error())
+	}
+
+	msgs, err := m.GetMsgs()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidScheduledExecution, err.Error())
+	}
+	for i, msg := range msgs {
+		vb, ok := msg.(sdk.HasValidateBasic)
+		if !ok {
+			continue
+		}
+		if err := vb.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidScheduledExecution, "message %d: %s", i, err)
+		}
+	}
+	return nil
+}
@@ -17,24 +17,35 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// MaxEpochsPerBlockLimit is the upper bound of max_epochs_per_block, it keeps
+// the number of epochs ended in a single block, and with it the hook work,
+// within a sane range.
+const MaxEpochsPerBlockLimit uint64 = 1000
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(catchUpPolicy CatchUpPolicy, maxEpochsPerBlock, historyRetention uint64) Params {
+	return Params{
+		CatchUpPolicy:     catchUpPolicy,
+		MaxEpochsPerBlock: maxEpochsPerBlock,
+		HistoryRetention:  historyRetention,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(CatchUpPolicyCapped, 1, 0)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -44,5 +55,37 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateCatchUpPolicy(p.CatchUpPolicy); err != nil {
+		return err
+	}
+	return validateMaxEpochsPerBlock(p.MaxEpochsPerBlock)
+}
+
+func validateCatchUpPolicy(policy CatchUpPolicy) error {
+	if _, ok := CatchUpPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("invalid catch up policy: %d", policy)
+	}
 	return nil
 }
+
+func validateMaxEpochsPerBlock(v uint64) error {
+	if v == 0 {
+		return fmt.Errorf("max epochs per block must be positive")
+	}
+	if v > MaxEpochsPerBlockLimit {
+		return fmt.Errorf("max epochs per block cannot exceed %d: %d", MaxEpochsPerBlockLimit, v)
+	}
+	return nil
+}
+
+// EpochsPerBlock returns the maximum number of missed epochs that can be
+// processed in a single block under the capped policy.
+func (p Params) EpochsPerBlock() int64 {
+	if p.MaxEpochsPerBlock == 0 {
+		return 1
+	}
+	if p.MaxEpochsPerBlock > MaxEpochsPerBlockLimit {
+		return int64(MaxEpochsPerBlockLimit)
+	}
+	return int64(p.MaxEpochsPerBlock)
+}
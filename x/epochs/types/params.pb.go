@@ -24,8 +24,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CatchUpPolicy defines how the module processes epochs that were missed,
+// e.g. after a chain halt, when more than one epoch duration has elapsed since
+// the start of the current epoch.
+type CatchUpPolicy int32
+
+const (
+	// CATCH_UP_POLICY_CAPPED processes at most max_epochs_per_block missed
+	// epochs per block. With max_epochs_per_block of 0 or 1 this is the
+	// original behaviour of advancing one epoch per block.
+	CatchUpPolicyCapped CatchUpPolicy = 0
+	// CATCH_UP_POLICY_ALL processes all missed epochs in a single block.
+	CatchUpPolicyAll CatchUpPolicy = 1
+	// CATCH_UP_POLICY_SKIP ends the current epoch, skips the missed epochs
+	// without calling hooks for them and starts the epoch matching the block
+	// time.
+	CatchUpPolicySkip CatchUpPolicy = 2
+)
+
+var CatchUpPolicy_name = map[int32]string{
+	0: "CATCH_UP_POLICY_CAPPED",
+	1: "CATCH_UP_POLICY_ALL",
+	2: "CATCH_UP_POLICY_SKIP",
+}
+
+var CatchUpPolicy_value = map[string]int32{
+	"CATCH_UP_POLICY_CAPPED": 0,
+	"CATCH_UP_POLICY_ALL":    1,
+	"CATCH_UP_POLICY_SKIP":   2,
+}
+
+func (x CatchUpPolicy) String() string {
+	return proto.EnumName(CatchUpPolicy_name, int32(x))
+}
+
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7e46fe965ca50966, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// catch_up_policy defines how missed epochs are processed
+	CatchUpPolicy CatchUpPolicy `protobuf:"varint,1,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=galactica.epochs.CatchUpPolicy" json:"catch_up_policy,omitempty"`
+	// max_epochs_per_block is the number of missed epochs processed per block
+	// under CATCH_UP_POLICY_CAPPED. 0 is treated as 1.
+	MaxEpochsPerBlock uint64 `protobuf:"varint,2,opt,name=max_epochs_per_block,json=maxEpochsPerBlock,proto3" json:"max_epochs_per_block,omitempty"`
+	// history_retention is the number of completed epochs per identifier kept
+	// in the epoch history. 0 keeps all records.
+	HistoryRetention uint64 `protobuf:"varint,3,opt,name=history_retention,json=historyRetention,proto3" json:"history_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,25 +107,61 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetCatchUpPolicy() CatchUpPolicy {
+	if m != nil {
+		return m.CatchUpPolicy
+	}
+	return CatchUpPolicyCapped
+}
+
+func (m *Params) GetMaxEpochsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxEpochsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryRetention() uint64 {
+	if m != nil {
+		return m.HistoryRetention
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("galactica.epochs.CatchUpPolicy", CatchUpPolicy_name, CatchUpPolicy_value)
 	proto.RegisterType((*Params)(nil), "galactica.epochs.Params")
 }
 
 func init() { proto.RegisterFile("galactica/epochs/params.proto", fileDescriptor_7e46fe965ca50966) }
 
 var fileDescriptor_7e46fe965ca50966 = []byte{
-	// 174 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4f, 0xcc, 0x49,
-	0x4c, 0x2e, 0xc9, 0x4c, 0x4e, 0xd4, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4b, 0xeb, 0x41, 0xa4,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x91, 0x94, 0x48, 0x7a, 0x7e,
-	0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0x74, 0xb8, 0xd8, 0x02, 0xc0, 0x46, 0x59,
-	0x29, 0xbd, 0x58, 0x20, 0xcf, 0xd8, 0xf5, 0x7c, 0x83, 0x96, 0x24, 0xc2, 0xb2, 0x0a, 0x98, 0x75,
-	0x10, 0x35, 0x4e, 0x3e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
-	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x94,
-	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x0e, 0xd3, 0xaf, 0x9b, 0x9c,
-	0x5f, 0x54, 0xa0, 0x8f, 0xc5, 0xb8, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x13, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x6e, 0x6e, 0x62, 0xde, 0x00, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x0e, 0xd2, 0x40,
+	0x18, 0xc7, 0x7b, 0x48, 0x18, 0x2e, 0x41, 0xdb, 0x82, 0x8a, 0x4d, 0x2c, 0x0d, 0x13, 0xc1, 0xd0,
+	0x26, 0xb0, 0xb9, 0x95, 0x4a, 0x90, 0xd8, 0xc4, 0x0b, 0xc8, 0xa0, 0xcb, 0xe5, 0x38, 0x9b, 0xb6,
+	0xa1, 0xe5, 0x2e, 0xed, 0x91, 0xc0, 0x1b, 0x98, 0x4e, 0xbe, 0x00, 0x89, 0x89, 0x9b, 0x93, 0x8f,
+	0xe1, 0x48, 0xe2, 0xe2, 0x68, 0x60, 0xd0, 0xc7, 0x30, 0x6d, 0x45, 0x2d, 0x71, 0xb9, 0x7c, 0xf7,
+	0xfd, 0x7f, 0xdf, 0x97, 0xfb, 0xff, 0x0f, 0x3e, 0xf6, 0x49, 0x44, 0xa8, 0x08, 0x29, 0xb1, 0x3c,
+	0xce, 0x68, 0x90, 0x5a, 0x9c, 0x24, 0x24, 0x4e, 0x4d, 0x9e, 0x30, 0xc1, 0x54, 0xf9, 0x8f, 0x6c,
+	0x96, 0xb2, 0xa6, 0x90, 0x38, 0xdc, 0x32, 0xab, 0x38, 0x4b, 0x48, 0x6b, 0xfb, 0xcc, 0x67, 0x45,
+	0x69, 0xe5, 0x55, 0xd9, 0xed, 0x7d, 0x05, 0xb0, 0x81, 0x8a, 0x5d, 0xea, 0x0c, 0xde, 0xa3, 0x44,
+	0xd0, 0x00, 0xef, 0x38, 0xe6, 0x2c, 0x0a, 0xe9, 0xa1, 0x03, 0x0c, 0xd0, 0xbf, 0x3b, 0xea, 0x9a,
+	0xb7, 0xfb, 0x4d, 0x27, 0x07, 0x57, 0x1c, 0x15, 0xd8, 0xa2, 0x49, 0xff, 0xbd, 0xaa, 0x16, 0x6c,
+	0xc7, 0x64, 0x8f, 0x4b, 0x14, 0x73, 0x2f, 0xc1, 0xeb, 0x88, 0xd1, 0x4d, 0xa7, 0x66, 0x80, 0x7e,
+	0x7d, 0xa1, 0xc4, 0x64, 0x3f, 0x2d, 0x24, 0xe4, 0x25, 0x93, 0x5c, 0x50, 0x9f, 0x40, 0x25, 0x08,
+	0x53, 0xc1, 0x92, 0x03, 0x4e, 0x3c, 0xe1, 0x6d, 0x45, 0xc8, 0xb6, 0x9d, 0x3b, 0x05, 0x2d, 0xff,
+	0x16, 0x16, 0xd7, 0xfe, 0xd3, 0xde, 0xcf, 0x0f, 0x5d, 0x90, 0xfd, 0xf8, 0x3c, 0x78, 0xf4, 0x37,
+	0x94, 0xfd, 0x35, 0x96, 0xd2, 0xca, 0xe0, 0x13, 0x80, 0xcd, 0xca, 0x13, 0xd5, 0x31, 0x7c, 0xe0,
+	0xd8, 0xaf, 0x9c, 0xe7, 0x78, 0x85, 0x30, 0x7a, 0xe9, 0xce, 0x9d, 0xd7, 0xd8, 0xb1, 0x11, 0x9a,
+	0x3e, 0x93, 0x25, 0xed, 0x61, 0x76, 0x34, 0x5a, 0x15, 0xdc, 0x21, 0x9c, 0x7b, 0x6f, 0xd5, 0x21,
+	0x6c, 0xdd, 0x0e, 0xd9, 0xae, 0x2b, 0x03, 0xad, 0x9d, 0x1d, 0x0d, 0xb9, 0x32, 0x61, 0x47, 0x51,
+	0xee, 0xfb, 0x16, 0x5f, 0xbe, 0x98, 0x23, 0xb9, 0xa6, 0xdd, 0xcf, 0x8e, 0x86, 0x52, 0xe1, 0x97,
+	0x9b, 0x90, 0x6b, 0xf5, 0x77, 0x1f, 0x75, 0x69, 0xe2, 0x7e, 0x39, 0xeb, 0xe0, 0x74, 0xd6, 0xc1,
+	0xf7, 0xb3, 0x0e, 0xde, 0x5f, 0x74, 0xe9, 0x74, 0xd1, 0xa5, 0x6f, 0x17, 0x5d, 0x7a, 0x33, 0xf2,
+	0x43, 0x11, 0xec, 0xd6, 0x26, 0x65, 0xb1, 0x35, 0xbb, 0x9a, 0x1d, 0x52, 0x96, 0x70, 0xeb, 0x3f,
+	0xde, 0xc5, 0x81, 0x7b, 0xe9, 0xba, 0x51, 0xfc, 0xeb, 0xf8, 0xd7, 0x00, 0x9a, 0x70, 0x06, 0x11,
+	0x33, 0x02, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -101,6 +183,15 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.CatchUpPolicy != that1.CatchUpPolicy {
+		return false
+	}
+	if this.MaxEpochsPerBlock != that1.MaxEpochsPerBlock {
+		return false
+	}
+	if this.HistoryRetention != that1.HistoryRetention {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -123,6 +214,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxEpochsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEpochsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CatchUpPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CatchUpPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -143,6 +249,15 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.CatchUpPolicy != 0 {
+		n += 1 + sovParams(uint64(m.CatchUpPolicy))
+	}
+	if m.MaxEpochsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxEpochsPerBlock))
+	}
+	if m.HistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.HistoryRetention))
+	}
 	return n
 }
 
@@ -181,6 +296,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			m.CatchUpPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpPolicy |= CatchUpPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochsPerBlock", wireType)
+			}
+			m.MaxEpochsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEpochsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetention", wireType)
+			}
+			m.HistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,74 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ScheduledExecution{}
+
+// NewScheduledExecution creates a scheduled execution of the given messages
+func NewScheduledExecution(id uint64, identifier string, epochNumber int64, msgs []sdk.Msg) (ScheduledExecution, error) {
+	anys, err := sdktx.SetMsgs(msgs)
+	if err != nil {
+		return ScheduledExecution{}, err
+	}
+
+	return ScheduledExecution{
+		Id:          id,
+		Identifier:  identifier,
+		EpochNumber: epochNumber,
+		Messages:    anys,
+	}, nil
+}
+
+// GetMsgs unpacks the messages of the scheduled execution
+func (se ScheduledExecution) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(se.Messages, "scheduled execution")
+}
+
+// UnpackInterfaces implements the UnpackInterfacesMessage interface
+func (se ScheduledExecution) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, se.Messages)
+}
+
+// Validate performs a stateless validation of the scheduled execution fields
+func (se ScheduledExecution) Validate() error {
+	if se.Id == 0 {
+		return errors.New("scheduled execution id cannot be 0")
+	}
+	return validateExecutionSchedule(se.Identifier, se.EpochNumber, se.Messages)
+}
+
+func validateExecutionSchedule(identifier string, epochNumber int64, messages []*codectypes.Any) error {
+	if err := ValidateEpochIdentifierString(identifier); err != nil {
+		return err
+	}
+	if epochNumber <= 0 {
+		return fmt.Errorf("scheduled epoch number must be positive: %d", epochNumber)
+	}
+	if len(messages) == 0 {
+		return errors.New("scheduled execution has no messages")
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package inflation
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	inflationv1 "github.com/Galactica-corp/galactica/api/galactica/inflation"
+	"github.com/Galactica-corp/galactica/x/inflation/client/cli"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. The
+// commands whose arguments autocli cannot express are kept in client/cli:
+// the params query, the params overrides of the emission simulation and the
+// share messages carrying a nested InflationShare.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              inflationv1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Skip:      true, // skipped because provided by client/cli
+				},
+				{
+					RpcMethod: "Period",
+					Use:       "period",
+					Short:     "shows the current inflation period",
+				},
+				{
+					RpcMethod: "EpochsPerPeriod",
+					Use:       "epochs-per-period",
+					Short:     "shows the number of epochs of an inflation period",
+				},
+				{
+					RpcMethod: "SkippedEpochs",
+					Use:       "skipped-epochs",
+					Short:     "shows the number of epochs without inflation",
+				},
+				{
+					RpcMethod: "EpochIdentifier",
+					Use:       "epoch-identifier",
+					Short:     "shows the identifier of the epochs inflation is minted for",
+				},
+				{
+					RpcMethod: "PeriodMintProvisions",
+					Use:       "period-mint-provisions",
+					Short:     "shows the mint provisions of all inflation periods",
+				},
+				{
+					RpcMethod: "InflationDistribution",
+					Use:       "inflation-distribution",
+					Short:     "shows the distribution of the minted coins",
+				},
+				{
+					RpcMethod: "EpochMintProvision",
+					Use:       "epoch-mint-provision",
+					Short:     "shows the coins minted in an epoch of the current period",
+				},
+				{
+					RpcMethod: "InflationRate",
+					Use:       "inflation-rate",
+					Short:     "shows the annualized inflation rate of the current period in percent",
+				},
+				{
+					RpcMethod: "CirculatingSupply",
+					Use:       "circulating-supply",
+					Short:     "shows the supply of the mint denom in circulation",
+				},
+				{
+					RpcMethod: "FailedEpochs",
+					Use:       "failed-epochs",
+					Short:     "shows the epochs whose inflation was not minted yet",
+				},
+				{
+					RpcMethod: "Remainder",
+					Use:       "remainder",
+					Short:     "shows the remainder carried to the next epoch and the remainder policy",
+				},
+				{
+					RpcMethod:      "EmissionPreview",
+					Use:            "emission-preview [periods]",
+					Short:          "shows the mint provisions of the next periods",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "periods", Optional: true}},
+				},
+				{
+					RpcMethod: "MaxSupply",
+					Use:       "max-supply",
+					Short:     "shows the max supply, the headroom left below it and whether it was reached",
+				},
+				{
+					RpcMethod: "DynamicInflation",
+					Use:       "dynamic-inflation",
+					Short:     "shows the multiplier of the epoch provision and the bonded ratio it responds to",
+				},
+				{
+					RpcMethod: "SimulateEmission",
+					Skip:      true, // skipped because the params overrides are provided by client/cli
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              inflationv1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "AddInflationShare",
+					Skip:      true, // skipped because the nested share is provided by client/cli
+				},
+				{
+					RpcMethod: "UpdateInflationShare",
+					Skip:      true, // skipped because the nested share is provided by client/cli
+				},
+				{
+					RpcMethod:      "RemoveInflationShare",
+					Use:            "remove-inflation-share [recipient]",
+					Short:          "removes a recipient of the inflation (authority only)",
+					Long:           cli.RecipientDescription,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "SetValidatorsShare",
+					Use:            "set-validators-share [share]",
+					Short:          "changes the share of the inflation allocated to validators (authority only)",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validators_share"}},
+				},
+				{
+					RpcMethod: "RetryFailedEpoch",
+					Use:       "retry-failed-epoch [epoch-number] [recipient]",
+					Short:     "mints and allocates the inflation of a failed epoch (authority only)",
+					Long: "Without a recipient the inflation of the epoch is allocated according to the current " +
+						"inflation distribution, otherwise the recipient receives all of it.\n\n" + cli.RecipientDescription,
+					Example:        fmt.Sprintf("$ %s tx inflation retry-failed-epoch 42\n$ %s tx inflation retry-failed-epoch 42 community_pool", version.AppName, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_number"}, {ProtoField: "recipient", Optional: true}},
+				},
+				{
+					RpcMethod:      "RedirectStrandedBalance",
+					Use:            "redirect-stranded-balance [recipient]",
+					Short:          "sends the coins left in the inflation module account to a recipient (authority only)",
+					Long:           cli.RecipientDescription,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,120 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagAuthority = "authority"
+	flagTitle     = "title"
+	flagSummary   = "summary"
+	flagMetadata  = "metadata"
+	flagDeposit   = "deposit"
+	flagExpedited = "expedited"
+)
+
+// RecipientDescription describes the recipient argument of the share commands
+const RecipientDescription = `The recipient of a share is one of:
+  <address>         an account address
+  module:<name>     a module account, e.g. module:distribution
+  community_pool    the community pool of x/distribution
+  burn              the coins are burned`
+
+// proposal is the gov proposal file accepted by `tx gov submit-proposal`
+type proposal struct {
+	Messages  []json.RawMessage `json:"messages"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+// addProposalFlags adds the flags of the gov proposal built by a command
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagAuthority, "", "authority of the module, defaults to the gov module account")
+	cmd.Flags().String(flagTitle, "", "title of the proposal")
+	cmd.Flags().String(flagSummary, "", "summary of the proposal")
+	cmd.Flags().String(flagMetadata, "", "metadata of the proposal")
+	cmd.Flags().String(flagDeposit, "", "deposit of the proposal")
+	cmd.Flags().Bool(flagExpedited, false, "whether the proposal is expedited")
+}
+
+// getAuthority returns the authority flag or the gov module account
+func getAuthority(cmd *cobra.Command) (string, error) {
+	authority, err := cmd.Flags().GetString(flagAuthority)
+	if err != nil {
+		return "", err
+	}
+	if authority == "" {
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	}
+	return authority, nil
+}
+
+// printProposal prints the gov proposal JSON executing the message, to be
+// submitted with `tx gov submit-proposal`
+func printProposal(cmd *cobra.Command, msg sdk.Msg) error {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	msgJSON, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return err
+	}
+
+	p := proposal{Messages: []json.RawMessage{msgJSON}}
+	if p.Title, err = cmd.Flags().GetString(flagTitle); err != nil {
+		return err
+	}
+	if p.Summary, err = cmd.Flags().GetString(flagSummary); err != nil {
+		return err
+	}
+	if p.Metadata, err = cmd.Flags().GetString(flagMetadata); err != nil {
+		return err
+	}
+	if p.Deposit, err = cmd.Flags().GetString(flagDeposit); err != nil {
+		return err
+	}
+	if p.Expedited, err = cmd.Flags().GetBool(flagExpedited); err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(p, "", " ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
@@ -41,6 +41,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQuerySimulateEmission())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
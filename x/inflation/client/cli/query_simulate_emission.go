@@ -0,0 +1,117 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+const (
+	flagParams               = "params"
+	flagPeriodMintProvisions = "period-mint-provisions"
+	flagEpochsPerPeriod      = "epochs-per-period"
+)
+
+func CmdQuerySimulateEmission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-emission [epochs]",
+		Short: "projects the minted coins, allocations and supply of the next epochs with proposed params",
+		Long: `Projects the emission of the next epochs, one period by default, without changing the state.
+The params, period mint provisions and epochs per period of the flags replace the current ones.
+The params file holds the JSON params of a MsgUpdateParams.`,
+		Example: "galacticad query inflation simulate-emission 365 --params params.json\n" +
+			"galacticad query inflation simulate-emission --period-mint-provisions 1000gnet,800gnet --epochs-per-period 52",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySimulateEmissionRequest{}
+			if len(args) > 0 {
+				epochs, err := strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid number of epochs %s: %w", args[0], err)
+				}
+				req.Epochs = uint32(epochs)
+			}
+
+			paramsFile, err := cmd.Flags().GetString(flagParams)
+			if err != nil {
+				return err
+			}
+			if paramsFile != "" {
+				bz, err := os.ReadFile(paramsFile)
+				if err != nil {
+					return err
+				}
+				var params types.Params
+				if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+					return fmt.Errorf("invalid params file %s: %w", paramsFile, err)
+				}
+				req.Params = &params
+			}
+
+			provisions, err := cmd.Flags().GetString(flagPeriodMintProvisions)
+			if err != nil {
+				return err
+			}
+			if provisions != "" {
+				// the provisions are ordered by period, so they are not parsed as
+				// sorted coins
+				for _, provision := range strings.Split(provisions, ",") {
+					coin, err := sdk.ParseDecCoin(strings.TrimSpace(provision))
+					if err != nil {
+						return fmt.Errorf("invalid period mint provision %s: %w", provision, err)
+					}
+					req.PeriodMintProvisions = append(req.PeriodMintProvisions, coin)
+				}
+			}
+
+			if req.EpochsPerPeriod, err = cmd.Flags().GetInt64(flagEpochsPerPeriod); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SimulateEmission(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagParams, "", "JSON file with the params to simulate")
+	cmd.Flags().String(flagPeriodMintProvisions, "", "comma separated mint provisions of the periods to simulate, e.g. 1000gnet,800gnet")
+	cmd.Flags().Int64(flagEpochsPerPeriod, 0, "epochs per period to simulate")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
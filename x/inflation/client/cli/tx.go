@@ -46,6 +46,8 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdAddInflationShare())
+	cmd.AddCommand(CmdUpdateInflationShare())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
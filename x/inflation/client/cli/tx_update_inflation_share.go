@@ -0,0 +1,59 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cli
+
+import (
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func CmdUpdateInflationShare() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-inflation-share [recipient] [share]",
+		Short: "builds a gov proposal changing the name and share of an inflation recipient",
+		Long:  RecipientDescription,
+		Example: "galacticad tx inflation update-inflation-share gala1... 0.1 --name dao > proposal.json\n" +
+			"galacticad tx gov submit-proposal proposal.json --from validator",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			authority, err := getAuthority(cmd)
+			if err != nil {
+				return err
+			}
+			share, err := math.LegacyNewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			name, err := cmd.Flags().GetString(flagName)
+			if err != nil {
+				return err
+			}
+
+			return printProposal(cmd, &types.MsgUpdateInflationShare{
+				Authority: authority,
+				Share:     types.NewInflationShare(args[0], name, share),
+			})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "name of the recipient")
+	addProposalFlags(cmd)
+
+	return cmd
+}
@@ -26,6 +26,17 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// the bank and epochs genesis are initialized first
+	if err := k.ValidateMintDenom(ctx, genState.Params.MintDenom); err != nil {
+		panic(err)
+	}
+	if err := k.ValidateEpochIdentifier(ctx, genState.EpochIdentifier); err != nil {
+		panic(err)
+	}
+	if err := k.ValidateRecipients(genState.Params.InflationDistribution); err != nil {
+		panic(err)
+	}
+
 	// Set genesis state
 	params := genState.Params
 	// this line is used by starport scaffolding # genesis/module/init
@@ -52,10 +63,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(errorsmod.Wrapf(err, "error setting period mint provisions"))
 	}
 
-	inflationDistribution := genState.InflationDistribution
-	err = k.SetInflationDistribution(ctx, inflationDistribution)
-	if err != nil {
-		panic(errorsmod.Wrapf(err, "error setting inflation distribution"))
+	for _, failed := range genState.FailedEpochs {
+		k.SetFailedEpoch(ctx, failed)
+	}
+
+	k.SetRemainder(ctx, genState.Remainder)
+	k.SetMaxSupplyReached(ctx, genState.MaxSupplyReached)
+
+	// genesis states exported before dynamic inflation have no multiplier
+	if !genState.Multiplier.IsNil() {
+		k.SetMultiplier(ctx, genState.Multiplier)
 	}
 }
 
@@ -74,5 +91,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		SkippedEpochs:   k.GetSkippedEpochs(ctx),
 
 		PeriodMintProvisions: periodMintProvisions,
+		FailedEpochs:         k.AllFailedEpochs(ctx),
+		Remainder:            k.GetRemainder(ctx),
+		MaxSupplyReached:     k.GetMaxSupplyReached(ctx),
+		Multiplier:           k.GetMultiplier(ctx),
 	}
 }
@@ -17,28 +17,111 @@ package inflation_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
 	"github.com/Galactica-corp/galactica/testutil/nullify"
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	epochstypes "github.com/Galactica-corp/galactica/x/epochs/types"
 	"github.com/Galactica-corp/galactica/x/inflation"
+	"github.com/Galactica-corp/galactica/x/inflation/keeper"
 	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
 
+// epochsKeeper is an epochs keeper that knows a fixed set of epochs
+type epochsKeeper map[string]epochstypes.EpochInfo
+
+func (e epochsKeeper) GetEpochInfo(_ sdk.Context, identifier string) (epochstypes.EpochInfo, bool) {
+	epoch, found := e[identifier]
+	return epoch, found
+}
+
+func genesisKeeper(t *testing.T, denom, identifier string) (keeper.Keeper, sdk.Context) {
+	bank := keepertest.NewBankKeeper()
+	if denom != "" {
+		bank.Metadata[denom] = banktypes.Metadata{Base: denom, Display: denom}
+	}
+	epochs := epochsKeeper{}
+	if identifier != "" {
+		epochs[identifier] = epochstypes.EpochInfo{Identifier: identifier}
+	}
+	return keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, nil, epochs, nil)
+}
+
 func TestGenesis(t *testing.T) {
+	distribution := types.InflationDistribution{
+		ValidatorsShare: math.LegacyMustNewDecFromStr("0.6"),
+		OtherShares: []*types.InflationShare{
+			{Address: sample.AccAddress(), Share: math.LegacyMustNewDecFromStr("0.3")},
+			{Address: sample.AccAddress(), Share: math.LegacyMustNewDecFromStr("0.1")},
+		},
+	}
+	params := types.DefaultParams()
+	params.InflationDistribution = distribution
+	params.RemainderPolicy = types.RemainderPolicyCarry
+	params.EnableInflation = false
+	params.MaxSupply = math.NewInt(1_000_000_000)
+
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:          params,
+		Period:          3,
+		EpochIdentifier: epochstypes.WeekEpochID,
+		EpochsPerPeriod: 52,
+		SkippedEpochs:   2,
 
+		PeriodMintProvisions: types.DefaultPeriodMintProvisions(),
+		FailedEpochs: []types.FailedEpoch{
+			{EpochNumber: 4, EpochIdentifier: epochstypes.WeekEpochID, Amount: sdk.NewInt64Coin(params.MintDenom, 100), Reason: "failed", Height: 10},
+			{EpochNumber: 7, EpochIdentifier: epochstypes.WeekEpochID, Amount: sdk.NewInt64Coin(params.MintDenom, 200), Reason: "failed", Height: 20},
+		},
+		Remainder:        sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 3)),
+		MaxSupplyReached: true,
+		Multiplier:       math.LegacyMustNewDecFromStr("1.25"),
 		// this line is used by starport scaffolding # genesis/test/state
 	}
+	require.NoError(t, genesisState.Validate())
 
-	k, ctx := keepertest.InflationKeeper(t)
+	k, ctx := genesisKeeper(t, params.MintDenom, genesisState.EpochIdentifier)
 	inflation.InitGenesis(ctx, k, genesisState)
 	got := inflation.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
 
+	require.Equal(t, genesisState, *got)
+	require.True(t, distribution.Equal(&got.Params.InflationDistribution))
+
+	// the exported genesis can be imported again
+	k2, ctx2 := genesisKeeper(t, params.MintDenom, genesisState.EpochIdentifier)
+	inflation.InitGenesis(ctx2, k2, *got)
+	require.Equal(t, *got, *inflation.ExportGenesis(ctx2, k2))
+
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestInitGenesisDependencies(t *testing.T) {
+	genesisState := *types.DefaultGenesis()
+	denom := genesisState.Params.MintDenom
+	identifier := genesisState.EpochIdentifier
+
+	t.Run("valid", func(t *testing.T) {
+		k, ctx := genesisKeeper(t, denom, identifier)
+		require.NotPanics(t, func() { inflation.InitGenesis(ctx, k, genesisState) })
+	})
+	t.Run("mint denom without metadata", func(t *testing.T) {
+		k, ctx := genesisKeeper(t, "", identifier)
+		require.PanicsWithError(t, types.ErrInvalidMintDenom.Wrapf("denom %s has no bank metadata", denom).Error(), func() {
+			inflation.InitGenesis(ctx, k, genesisState)
+		})
+	})
+	t.Run("unknown epoch identifier", func(t *testing.T) {
+		k, ctx := genesisKeeper(t, denom, "")
+		require.PanicsWithError(t, types.ErrInvalidEpochIdentifier.Wrapf("epoch identifier %s not found", identifier).Error(), func() {
+			inflation.InitGenesis(ctx, k, genesisState)
+		})
+	})
+}
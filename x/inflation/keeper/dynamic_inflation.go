@@ -0,0 +1,93 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// GetMultiplier returns the multiplier of dynamic inflation, one if it was
+// never set
+func (k Keeper) GetMultiplier(ctx sdk.Context) math.LegacyDec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MultiplierKey)
+	if len(bz) == 0 {
+		return math.LegacyOneDec()
+	}
+
+	var multiplier math.LegacyDec
+	if err := multiplier.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return multiplier
+}
+
+// SetMultiplier stores the multiplier of dynamic inflation
+func (k Keeper) SetMultiplier(ctx sdk.Context, multiplier math.LegacyDec) {
+	bz, err := multiplier.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MultiplierKey, bz)
+}
+
+// getMultiplier returns the multiplier applied to the scheduled epoch
+// provision, which is one unless dynamic inflation is enabled
+func (k Keeper) getMultiplier(ctx sdk.Context, params types.Params) math.LegacyDec {
+	if params.DynamicInflation == nil {
+		return math.LegacyOneDec()
+	}
+	return params.DynamicInflation.ClampMultiplier(k.GetMultiplier(ctx))
+}
+
+// updateMultiplier moves the multiplier of dynamic inflation toward the target
+// bonded ratio. The multiplier is kept if the bonded ratio is unavailable.
+func (k Keeper) updateMultiplier(ctx sdk.Context, dynamic types.DynamicInflation, epochNumber int64) {
+	bondedRatio, err := k.GetBondedRatio(ctx)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"keeping inflation multiplier: error getting bonded ratio",
+			"error", err.Error(),
+			"epoch", epochNumber,
+		)
+		return
+	}
+
+	multiplier := types.CalculateMultiplier(dynamic, k.GetMultiplier(ctx), bondedRatio, k.GetEpochsPerPeriod(ctx))
+	k.SetMultiplier(ctx, multiplier)
+
+	k.Logger(ctx).Debug(
+		"updated inflation multiplier",
+		"epoch", epochNumber,
+		"bonded-ratio", bondedRatio.String(),
+		"multiplier", multiplier.String(),
+	)
+}
+
+// GetBondedRatio returns the fraction of the staking tokens that is bonded
+func (k Keeper) GetBondedRatio(ctx sdk.Context) (math.LegacyDec, error) {
+	if k.stakingKeeper == nil {
+		return math.LegacyDec{}, errors.New("staking keeper not set")
+	}
+	return k.stakingKeeper.BondedRatio(ctx)
+}
@@ -0,0 +1,79 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// GetFailedEpoch returns the record of a failed epoch
+func (k Keeper) GetFailedEpoch(ctx sdk.Context, epochNumber int64) (types.FailedEpoch, bool) {
+	failed := types.FailedEpoch{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedEpoch)
+	bz := store.Get(types.FailedEpochKey(epochNumber))
+	if len(bz) == 0 {
+		return failed, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &failed)
+	return failed, true
+}
+
+// SetFailedEpoch stores the record of a failed epoch
+func (k Keeper) SetFailedEpoch(ctx sdk.Context, failed types.FailedEpoch) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedEpoch)
+	bz := k.cdc.MustMarshal(&failed)
+	store.Set(types.FailedEpochKey(failed.EpochNumber), bz)
+}
+
+// DeleteFailedEpoch deletes the record of a failed epoch
+func (k Keeper) DeleteFailedEpoch(ctx sdk.Context, epochNumber int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedEpoch)
+	store.Delete(types.FailedEpochKey(epochNumber))
+}
+
+// IterateFailedEpochs iterates through the failed epochs in ascending order of
+// their epoch number
+func (k Keeper) IterateFailedEpochs(ctx sdk.Context, fn func(failed types.FailedEpoch) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedEpoch)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		failed := types.FailedEpoch{}
+		k.cdc.MustUnmarshal(iterator.Value(), &failed)
+
+		if fn(failed) {
+			break
+		}
+	}
+}
+
+// AllFailedEpochs returns every failed epoch in the store
+func (k Keeper) AllFailedEpochs(ctx sdk.Context) []types.FailedEpoch {
+	failedEpochs := []types.FailedEpoch{}
+	k.IterateFailedEpochs(ctx, func(failed types.FailedEpoch) (stop bool) {
+		failedEpochs = append(failedEpochs, failed)
+		return false
+	})
+	return failedEpochs
+}
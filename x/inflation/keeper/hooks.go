@@ -19,18 +19,19 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	epochstypes "github.com/Galactica-corp/galactica/x/epochs/types"
 	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
 
-// TODO: switch BeforeEpochStart and AfterEpochEnd
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, _ string, _ int64) {
-}
-
-// AfterEpochEnd mints and allocates coins at the end of each epoch end
+// BeforeEpochStart mints and allocates the coins of an epoch in its first
+// block, including the first epoch of the inflation epoch identifier. The
+// epoch number is the number of the starting epoch. While inflation is
+// disabled, epochs are skipped and do not count towards the periods. The mint
+// is scaled by the multiplier of dynamic inflation and clamped to the max
+// supply, and inflation is switched off permanently once it is reached.
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
 	params := k.GetParams(ctx)
 
@@ -39,9 +40,41 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 		return
 	}
 
-	period := k.GetPeriod(ctx)
-	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
-	periodMintProvisions, err := k.GetPeriodMintProvisions(ctx)
+	// Inflation stays switched off once the max supply was reached
+	if k.GetMaxSupplyReached(ctx) {
+		return
+	}
+
+	// Skip inflation if it is disabled and increment the number of skipped
+	// epochs
+	if !params.EnableInflation {
+		skippedEpochs := k.GetSkippedEpochs(ctx) + 1
+		k.SetSkippedEpochs(ctx, skippedEpochs)
+		k.Logger(ctx).Debug(
+			"skipping inflation mint and allocation",
+			"epoch", epochNumber,
+			"skipped-epochs", skippedEpochs,
+		)
+		return
+	}
+
+	k.mintEpoch(ctx, params, epochIdentifier, epochNumber)
+
+	// If a period has passed, move on to the next period after minting, so
+	// that the epoch passing a period is still minted with its provision
+	k.advancePeriod(ctx, epochNumber)
+}
+
+// mintEpoch mints and allocates the provision of the starting epoch in the
+// current period. A failed mint or allocation is recorded as failed epoch.
+func (k Keeper) mintEpoch(ctx sdk.Context, params types.Params, epochIdentifier string, epochNumber int64) {
+	// Move the multiplier of dynamic inflation toward the target bonded ratio
+	// before it scales the provision of this epoch
+	if params.DynamicInflation != nil {
+		k.updateMultiplier(ctx, *params.DynamicInflation, epochNumber)
+	}
+
+	epochMintProvision, err := k.getScheduledEpochMintProvision(ctx, params)
 	if err != nil {
 		k.Logger(ctx).Error(
 			"SKIPPING INFLATION: error getting period mint provisions",
@@ -50,11 +83,19 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 		return
 	}
 
-	epochMintProvision := types.CalculateEpochMintProvision(
-		periodMintProvisions,
-		period,
-		epochsPerPeriod,
-	)
+	// Clamp the mint to the headroom left below the max supply. Inflation is
+	// switched off once there is no headroom left.
+	headroom, limited := k.getSupplyHeadroom(ctx, params)
+	if limited {
+		if !headroom.IsPositive() {
+			k.reachMaxSupply(ctx, params, epochNumber)
+			return
+		}
+		if epochMintProvision.GT(headroom) {
+			k.emitMintClamped(ctx, params, epochNumber, epochMintProvision, headroom)
+			epochMintProvision = headroom
+		}
+	}
 
 	if !epochMintProvision.IsPositive() {
 		k.Logger(ctx).Error(
@@ -72,134 +113,111 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 	if mintedCoin.Amount.IsNil() || !mintedCoin.Amount.IsPositive() {
 		return
 	}
-	err = k.MintCoins(ctx, mintedCoin)
-	if err != nil {
-		k.Logger(ctx).Error(
-			"SKIPPING INFLATION: error minting coins",
-			"error", err.Error(),
-			"coin", mintedCoin.String(),
-			"denom", mintedCoin.Denom,
-		)
-		return
-	}
 
-	// Allocate staking rewards into fee collector account
-	distribution, found := k.GetInflationDistribution(ctx)
-	if !found {
-		k.Logger(ctx).Error("SKIPPING INFLATION: inflation distribution not found")
-		return
-	}
+	distribution := params.InflationDistribution
 
 	k.Logger(ctx).With(
 		"ValidatorsShare", distribution.ValidatorsShare.String(),
 		"OtherSharesLen", len(distribution.OtherShares),
 	).Info("INFLATION MODULE: distribution")
 
-	if len(distribution.OtherShares) > 0 {
-		k.Logger(ctx).With(
-			"OtherShares[0].Name", distribution.OtherShares[0].Name,
-			"OtherShares[0].Address", distribution.OtherShares[0].Address,
-			"OtherShares[0].Share", distribution.OtherShares[0].Share.String(),
-		).Info("INFLATION MODULE: OtherShares")
-	}
+	// Mint and allocate in a cached context, so that either all recipients
+	// are paid or nothing is minted at all
+	cacheCtx, writeCache := ctx.CacheContext()
+	remainder, err := k.MintAndAllocateInflation(cacheCtx, mintedCoin, distribution)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"SKIPPING INFLATION: error minting and allocating coins",
+			"error", err.Error(),
+			"coin", mintedCoin.String(),
+			"epoch", epochNumber,
+		)
+		k.recordFailedEpoch(ctx, epochIdentifier, epochNumber, mintedCoin, err)
+	} else {
+		writeCache()
 
-	staking := sdk.Coins{k.GetProportions(ctx, mintedCoin, distribution.ValidatorsShare)}
-	if !staking.IsZero() {
-		if err := k.bankKeeper.SendCoinsFromModuleToModule(
-			ctx,
-			types.ModuleName,
-			authtypes.FeeCollectorName,
-			staking,
-		); err != nil {
-			k.Logger(ctx).Error(
-				"SKIPPING INFLATION: error sending coins to validators from module to module",
-				"error", err.Error(),
-			)
-			return
-		} else {
-			k.Logger(ctx).Info(
-				"INFLATION MODULE: sent coins to validators from module to module",
-				"coins", staking.String(),
-			)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMint,
+				sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
+				sdk.NewAttribute(types.AttributeKeyEpochProvisions, epochMintProvision.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyRemainder, remainder.String()),
+				sdk.NewAttribute(types.AttributeKeyCarried, k.GetRemainder(ctx).String()),
+				sdk.NewAttribute(types.AttributeKeyMultiplier, k.getMultiplier(ctx, params).String()),
+			),
+		)
+
+		// Burned shares leave headroom, so the supply is checked after the
+		// allocation
+		if headroom, limited := k.getSupplyHeadroom(ctx, params); limited && !headroom.IsPositive() {
+			k.reachMaxSupply(ctx, params, epochNumber)
 		}
 	}
 
-	// allocate inflation to other addresses
-	for _, share := range distribution.OtherShares {
-		other := sdk.Coins{k.GetProportions(ctx, mintedCoin, share.Share)}
-		otherAddress, err := sdk.AccAddressFromBech32(share.Address)
-		if err != nil {
-			k.Logger(ctx).Error(
-				"SKIPPING INFLATION: error getting address from bech32",
-				"error", err.Error(),
-			)
-			return
-		}
+	// TODO: telemetry
+}
 
-		if !other.IsZero() {
-			if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-				ctx,
-				types.ModuleName,
-				otherAddress,
-				other,
-			); err != nil {
-				k.Logger(ctx).Error(
-					"SKIPPING INFLATION: error sending coins to other from module",
-					"error", err.Error(),
-				)
-			} else {
-				k.Logger(ctx).Info(
-					"INFLATION MODULE: sent coins to other from module",
-					"coins", other.String(),
-					"otherAddress", otherAddress.String(),
-				)
-			}
-
-			// if err := k.distrKeeper.FundCommunityPool(
-			//	ctx,
-			//	other,
-			//	otherAddress,
-			// ); err != nil {
-			//	k.Logger(ctx).Error(
-			//		"SKIPPING INFLATION: error sending coins to other from module to module",
-			//		"error", err.Error(),
-			//	)
-			//	return
-			// }
-
-		} else {
-			k.Logger(ctx).Info(
-				"INFLATION MODULE: other is zero",
-			)
-		}
+// advancePeriod moves on to the next period if a period has passed with the
+// epoch. A period has passed if the epochs with inflation surpass the
+// epochsPerPeriod of the current period. Skipped epochs are subtracted, so
+// that the schedule resumes where it stopped when inflation is enabled again.
+//
+// Examples:
+//
+//	Given, epochNumber = 1, period = 0, epochPerPeriod = 365, skippedEpochs = 0
+//	  => 1 - 365 * 0 - 0 <= 365 --- nothing to do here
+//	Given, epochNumber = 366, period = 0, epochPerPeriod = 365, skippedEpochs = 0
+//	  => 366 - 365 * 0 - 0 > 365 --- a period has passed! we set a new period
+//	Given, epochNumber = 741, period = 1, epochPerPeriod = 365, skippedEpochs = 10
+//	  => 741 - 365 * 1 - 10 > 365 --- a period has passed! we set a new period
+//
+// The period does not advance past the end of the period mint provisions,
+// after which nothing is minted.
+func (k Keeper) advancePeriod(ctx sdk.Context, epochNumber int64) {
+	period := k.GetPeriod(ctx)
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	skippedEpochs := k.GetSkippedEpochs(ctx)
+	if epochNumber-epochsPerPeriod*int64(period)-int64(skippedEpochs) > epochsPerPeriod && !k.scheduleEnded(ctx, period) {
+		k.SetPeriod(ctx, period+1)
 	}
+}
 
-	// If period is passed, update the period. A period is
-	// passed if the current epoch number surpasses the epochsPerPeriod for the
-	// current period.
-	//
-	// Examples:
-	// Given, epochNumber = 1, period = 0, epochPerPeriod = 365
-	//   => 1 - 365 * 0 - 0 < 365 --- nothing to do here
-	// Given, epochNumber = 741, period = 1, epochPerPeriod = 365
-	//   => 741 - 1 * 365 - 10 > 365 --- a period has passed! we set a new period
-	if epochNumber-epochsPerPeriod*int64(period) > epochsPerPeriod {
-		period++
-		k.SetPeriod(ctx, period)
+// recordFailedEpoch stores the epoch whose inflation could not be minted and
+// allocated, so that it can be retried through governance
+func (k Keeper) recordFailedEpoch(ctx sdk.Context, identifier string, epochNumber int64, coin sdk.Coin, reason error) {
+	failed := types.FailedEpoch{
+		EpochNumber:     epochNumber,
+		EpochIdentifier: identifier,
+		Amount:          coin,
+		Reason:          reason.Error(),
+		Height:          ctx.BlockHeight(),
 	}
+	k.SetFailedEpoch(ctx, failed)
 
-	// TODO: telemetry
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochAllocationFailed{FailedEpoch: failed}); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "error", err.Error())
+	}
+}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeMint,
-			sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochNumber)),
-			sdk.NewAttribute(types.AttributeKeyEpochProvisions, epochMintProvision.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, mintedCoin.Amount.String()),
-		),
+// emitMintClamped emits the event of an epoch whose mint was reduced to the
+// headroom left below the max supply
+func (k Keeper) emitMintClamped(ctx sdk.Context, params types.Params, epochNumber int64, provision, headroom math.LegacyDec) {
+	k.Logger(ctx).Info(
+		"clamping inflation to the max supply",
+		"epoch", epochNumber,
+		"provision", provision.String(),
+		"headroom", headroom.String(),
 	)
 
-	k.Logger(ctx).Info("******* AFTER EPOCH END *******")
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintClamped{
+		EpochNumber:    epochNumber,
+		EpochProvision: provision,
+		Headroom:       headroom.TruncateInt(),
+		MaxSupply:      params.MaxSupply,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "error", err.Error())
+	}
 }
 
 // ___________________________________________________________________________________________________
@@ -209,7 +227,10 @@ type Hooks struct {
 	k Keeper
 }
 
-var _ epochstypes.EpochHooks = Hooks{}
+var (
+	_ epochstypes.EpochHooksV2         = Hooks{}
+	_ epochstypes.EpochDependencyHooks = Hooks{}
+)
 
 // Return the wrapper struct
 func (k Keeper) Hooks() Hooks {
@@ -217,10 +238,23 @@ func (k Keeper) Hooks() Hooks {
 }
 
 // epochs hooks
-func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epoch epochstypes.EpochContext) error {
+	h.k.BeforeEpochStart(ctx, epoch.Identifier, epoch.EpochNumber)
+	return nil
+}
+
+// AfterEpochEnd is a no-op, the inflation of an epoch is minted when it starts
+func (h Hooks) AfterEpochEnd(_ sdk.Context, _ epochstypes.EpochContext) error {
+	return nil
+}
+
+// GetModuleName implements the epochs EpochHooksV2 interface
+func (h Hooks) GetModuleName() string {
+	return types.ModuleName
 }
 
-func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
-	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+// EpochIdentifiersInUse returns the epoch identifier inflation is minted on,
+// so that it cannot be deleted
+func (h Hooks) EpochIdentifiersInUse(ctx sdk.Context) []string {
+	return []string{h.k.GetEpochIdentifier(ctx)}
 }
@@ -0,0 +1,533 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	"github.com/Galactica-corp/galactica/x/inflation/keeper"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestBeforeEpochStartRecipientKinds(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	distr := keepertest.NewDistrKeeper(bank)
+	k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, distr, nil, nil)
+
+	account := sample.AccAddress()
+	require.NoError(t, k.SetInflationDistribution(ctx, types.InflationDistribution{
+		ValidatorsShare: math.LegacyNewDecWithPrec(5, 1),
+		OtherShares: []*types.InflationShare{
+			{Name: "account", Address: account, Share: math.LegacyNewDecWithPrec(2, 1)},
+			{Name: "gov", Kind: types.RecipientKindModule, ModuleName: govtypes.ModuleName, Share: math.LegacyNewDecWithPrec(1, 1)},
+			{Name: "community", Kind: types.RecipientKindCommunityPool, Share: math.LegacyNewDecWithPrec(1, 1)},
+			{Name: "burn", Kind: types.RecipientKindBurn, Share: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	}))
+	denom := k.GetParams(ctx).MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 1)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(1000)))))
+
+	k.BeforeEpochStart(ctx, "day", 1)
+
+	balance := func(addr sdk.AccAddress) math.Int {
+		return bank.GetBalance(ctx, addr, denom).Amount
+	}
+	require.Equal(t, math.NewInt(500), balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+	require.Equal(t, math.NewInt(200), balance(sdk.MustAccAddressFromBech32(account)))
+	require.Equal(t, math.NewInt(100), balance(authtypes.NewModuleAddress(govtypes.ModuleName)))
+	require.Equal(t, math.NewInt(100), balance(authtypes.NewModuleAddress(distrtypes.ModuleName)))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(100))), distr.CommunityPool(ctx))
+	require.True(t, balance(authtypes.NewModuleAddress(types.ModuleName)).IsZero())
+
+	// the burn share is minted and burned in the same epoch
+	require.Equal(t, math.NewInt(900), bank.GetSupply(ctx, denom).Amount)
+}
+
+func TestSendShareUnknownModule(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+
+	share := types.InflationShare{Kind: types.RecipientKindModule, ModuleName: "unknown", Share: math.LegacyOneDec()}
+	err := k.SendShare(ctx, share, sdk.NewCoins(sdk.NewCoin("gnet", math.OneInt())))
+	require.ErrorIs(t, err, types.ErrInvalidDistribution)
+
+	// the distribution is rejected before it is stored
+	err = k.SetInflationDistribution(ctx, types.InflationDistribution{
+		ValidatorsShare: math.LegacyZeroDec(),
+		OtherShares:     []*types.InflationShare{&share},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDistribution)
+	defaultDistribution := types.DefaultInflationDistribution()
+	require.True(t, k.GetInflationDistribution(ctx).Equal(&defaultDistribution))
+}
+
+func TestBeforeEpochStartAllocationFailure(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+	ctx = ctx.WithBlockHeight(10)
+
+	// the params accept a module share without checking that the module
+	// account exists, so that the allocation fails after paying the account
+	account := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.InflationDistribution = types.InflationDistribution{
+		ValidatorsShare: math.LegacyNewDecWithPrec(5, 1),
+		OtherShares: []*types.InflationShare{
+			{Name: "account", Address: account, Share: math.LegacyNewDecWithPrec(3, 1)},
+			{Name: "unknown", Kind: types.RecipientKindModule, ModuleName: "unknown", Share: math.LegacyNewDecWithPrec(2, 1)},
+		},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	denom := params.MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 1)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(1000)),
+		sdk.NewDecCoin(denom, math.NewInt(1000)),
+	}))
+
+	k.BeforeEpochStart(ctx, "day", 2)
+
+	// nothing is minted or paid
+	require.True(t, bank.GetSupply(ctx, denom).IsZero())
+	require.True(t, bank.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), denom).IsZero())
+	require.True(t, bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(account), denom).IsZero())
+
+	failed, found := k.GetFailedEpoch(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, "day", failed.EpochIdentifier)
+	require.Equal(t, sdk.NewInt64Coin(denom, 1000), failed.Amount)
+	require.Equal(t, int64(10), failed.Height)
+	require.Contains(t, failed.Reason, "module account unknown not found")
+
+	event, ok := lastTypedEvent(t, ctx).(*types.EventEpochAllocationFailed)
+	require.True(t, ok)
+	require.Equal(t, failed, event.FailedEpoch)
+
+	// the period advances regardless of the failure
+	require.Equal(t, uint64(1), k.GetPeriod(ctx))
+}
+
+func TestBeforeEpochStartRemainderPolicy(t *testing.T) {
+	account := sample.AccAddress()
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	communityPool := authtypes.NewModuleAddress(distrtypes.ModuleName)
+	module := authtypes.NewModuleAddress(types.ModuleName)
+
+	testCases := []struct {
+		policy        types.RemainderPolicy
+		feeCollector  int64
+		communityPool int64
+		module        int64
+		supply        int64
+	}{
+		{types.RemainderPolicyValidators, 667, 0, 0, 1000},
+		{types.RemainderPolicyCarry, 500, 0, 167, 1000},
+		{types.RemainderPolicyCommunityPool, 500, 167, 0, 1000},
+		{types.RemainderPolicyBurn, 500, 0, 0, 833},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.policy.String(), func(t *testing.T) {
+			bank := keepertest.NewBankKeeper()
+			k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, keepertest.NewDistrKeeper(bank), nil, nil)
+
+			// the account share is truncated and the shares sum up to less than 1
+			params := k.GetParams(ctx)
+			params.RemainderPolicy = tc.policy
+			params.InflationDistribution = types.InflationDistribution{
+				ValidatorsShare: math.LegacyNewDecWithPrec(5, 1),
+				OtherShares: []*types.InflationShare{
+					{Name: "account", Address: account, Share: math.LegacyNewDecWithPrec(333, 3)},
+				},
+			}
+			require.NoError(t, k.SetParams(ctx, params))
+			denom := params.MintDenom
+			k.SetEpochIdentifier(ctx, "day")
+			k.SetEpochsPerPeriod(ctx, 10)
+			require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(10000)))))
+
+			k.BeforeEpochStart(ctx, "day", 1)
+
+			balance := func(addr sdk.AccAddress) int64 {
+				return bank.GetBalance(ctx, addr, denom).Amount.Int64()
+			}
+			require.Equal(t, tc.feeCollector, balance(feeCollector))
+			require.Equal(t, int64(333), balance(sdk.MustAccAddressFromBech32(account)))
+			require.Equal(t, tc.communityPool, balance(communityPool))
+			require.Equal(t, tc.module, balance(module))
+			require.Equal(t, tc.supply, bank.GetSupply(ctx, denom).Amount.Int64())
+			require.True(t, bank.GetAllBalances(ctx, module).Equal(k.GetRemainder(ctx)))
+
+			events := ctx.EventManager().Events()
+			mint := events[len(events)-1]
+			require.Equal(t, types.EventTypeMint, mint.Type)
+			remainder, found := mint.GetAttribute(types.AttributeKeyRemainder)
+			require.True(t, found)
+			require.Equal(t, "167"+denom, remainder.Value)
+
+			_, broken := keeper.ModuleBalanceInvariant(k)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestBeforeEpochStartCarriedRemainder(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+	module := authtypes.NewModuleAddress(types.ModuleName)
+
+	params := k.GetParams(ctx)
+	params.RemainderPolicy = types.RemainderPolicyCarry
+	params.InflationDistribution = types.InflationDistribution{ValidatorsShare: math.LegacyNewDecWithPrec(5, 1)}
+	require.NoError(t, k.SetParams(ctx, params))
+	denom := params.MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 10)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(10010)))))
+
+	// 1001 are minted in each epoch, the carried half is allocated in the
+	// next epoch
+	k.BeforeEpochStart(ctx, "day", 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 501)), k.GetRemainder(ctx))
+
+	k.BeforeEpochStart(ctx, "day", 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 751)), k.GetRemainder(ctx))
+	require.Equal(t, int64(751), bank.GetBalance(ctx, module, denom).Amount.Int64())
+	require.Equal(t, int64(1251), bank.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), denom).Amount.Int64())
+
+	// the carried remainder is no longer carried once the policy changes
+	params.RemainderPolicy = types.RemainderPolicyBurn
+	require.NoError(t, k.SetParams(ctx, params))
+	k.BeforeEpochStart(ctx, "day", 3)
+	require.True(t, k.GetRemainder(ctx).IsZero())
+	require.True(t, bank.GetBalance(ctx, module, denom).IsZero())
+	require.Equal(t, int64(3*1001-876), bank.GetSupply(ctx, denom).Amount.Int64())
+}
+
+func TestBeforeEpochStartSkippedEpochs(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+
+	denom := k.GetParams(ctx).MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 2)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(200)),
+		sdk.NewDecCoin(denom, math.NewInt(400)),
+		sdk.NewDecCoin(denom, math.NewInt(600)),
+	}))
+
+	// the period advances after the epoch that passes it, so the first period
+	// has three epochs with inflation and the following ones two. Skipped
+	// epochs are not counted and the schedule resumes where it stopped.
+	testCases := []struct {
+		epochNumber   int64
+		enabled       bool
+		minted        int64
+		period        uint64
+		skippedEpochs uint64
+	}{
+		{1, true, 100, 0, 0},
+		{2, false, 0, 0, 1},
+		{3, false, 0, 0, 2},
+		{4, true, 100, 0, 2},
+		{5, true, 100, 1, 2},
+		{6, false, 0, 1, 3},
+		{7, true, 200, 1, 3},
+		{8, true, 200, 2, 3},
+		{9, false, 0, 2, 4},
+		{10, true, 300, 2, 4},
+	}
+
+	for _, tc := range testCases {
+		params := k.GetParams(ctx)
+		params.EnableInflation = tc.enabled
+		require.NoError(t, k.SetParams(ctx, params))
+
+		supply := bank.GetSupply(ctx, denom).Amount
+		k.BeforeEpochStart(ctx, "day", tc.epochNumber)
+
+		require.Equal(t, tc.minted, bank.GetSupply(ctx, denom).Amount.Sub(supply).Int64(), "epoch %d", tc.epochNumber)
+		require.Equal(t, tc.period, k.GetPeriod(ctx), "epoch %d", tc.epochNumber)
+		require.Equal(t, tc.skippedEpochs, k.GetSkippedEpochs(ctx), "epoch %d", tc.epochNumber)
+	}
+
+	// epochs of other identifiers are not skipped
+	params := k.GetParams(ctx)
+	params.EnableInflation = false
+	require.NoError(t, k.SetParams(ctx, params))
+	k.BeforeEpochStart(ctx, "week", 1)
+	require.Equal(t, uint64(4), k.GetSkippedEpochs(ctx))
+}
+
+func TestBeforeEpochStartEmissionSchedule(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+
+	denom := k.GetParams(ctx).MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 2)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(200)),
+		sdk.NewDecCoin(denom, math.NewInt(100)),
+	}))
+
+	mint := func(epochNumber int64) int64 {
+		supply := bank.GetSupply(ctx, denom).Amount
+		k.BeforeEpochStart(ctx, "day", epochNumber)
+		return bank.GetSupply(ctx, denom).Amount.Sub(supply).Int64()
+	}
+
+	// the period mint provisions end after their last period
+	for epochNumber, minted := range []int64{100, 100, 100, 50, 50, 0} {
+		require.Equal(t, minted, mint(int64(epochNumber+1)), "epoch %d", epochNumber+1)
+	}
+	require.Equal(t, uint64(2), k.GetPeriod(ctx))
+
+	// the emission schedule continues from the current period
+	params := k.GetParams(ctx)
+	params.EmissionSchedule = &types.EmissionSchedule{
+		InitialProvision: math.LegacyNewDec(800),
+		DecayFactor:      math.LegacyMustNewDecFromStr("0.5"),
+		FloorProvision:   math.LegacyNewDec(60),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	for epochNumber, minted := range []int64{100, 50, 50, 30, 30, 30} {
+		require.Equal(t, minted, mint(int64(epochNumber+7)), "epoch %d", epochNumber+7)
+	}
+	require.Equal(t, uint64(5), k.GetPeriod(ctx))
+}
+
+func TestBeforeEpochStartPeriodBoundary(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+
+	denom := k.GetParams(ctx).MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 365)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(730)),
+		sdk.NewDecCoin(denom, math.NewInt(365)),
+	}))
+
+	mint := func(epochNumber int64) int64 {
+		supply := bank.GetSupply(ctx, denom).Amount
+		k.BeforeEpochStart(ctx, "day", epochNumber)
+		return bank.GetSupply(ctx, denom).Amount.Sub(supply).Int64()
+	}
+
+	for epochNumber := int64(1); epochNumber < 365; epochNumber++ {
+		require.Equal(t, int64(2), mint(epochNumber), "epoch %d", epochNumber)
+	}
+
+	// the epoch that passes the period is minted with the provision of the
+	// period and the next period starts after it
+	require.Equal(t, int64(2), mint(365))
+	require.Zero(t, k.GetPeriod(ctx))
+	require.Equal(t, int64(2), mint(366))
+	require.Equal(t, uint64(1), k.GetPeriod(ctx))
+	require.Equal(t, int64(1), mint(367))
+	require.Equal(t, uint64(1), k.GetPeriod(ctx))
+}
+
+func TestBeforeEpochStartMaxSupply(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		supply    int64
+		maxSupply int64
+		minted    []int64
+		clamped   bool
+	}{
+		{
+			name:      "clamped to the headroom",
+			maxSupply: 250,
+			minted:    []int64{100, 100, 50, 0, 0},
+			clamped:   true,
+		},
+		{
+			name:      "headroom minted in full",
+			maxSupply: 200,
+			minted:    []int64{100, 100, 0, 0},
+		},
+		{
+			name:      "supply above the max supply",
+			supply:    300,
+			maxSupply: 250,
+			minted:    []int64{0, 0},
+		},
+		{
+			name:   "no max supply",
+			minted: []int64{100, 100, 100, 100},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bank := keepertest.NewBankKeeper()
+			k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+
+			params := k.GetParams(ctx)
+			params.MaxSupply = math.NewInt(tc.maxSupply)
+			require.NoError(t, k.SetParams(ctx, params))
+			denom := params.MintDenom
+			k.SetEpochIdentifier(ctx, "day")
+			k.SetEpochsPerPeriod(ctx, 10)
+			require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{sdk.NewDecCoin(denom, math.NewInt(1000))}))
+			if tc.supply > 0 {
+				require.NoError(t, bank.MintCoins(ctx, "minter", sdk.NewCoins(sdk.NewInt64Coin(denom, tc.supply))))
+			}
+
+			var clamped bool
+			for i, minted := range tc.minted {
+				epochNumber := int64(i + 1)
+				ctx = ctx.WithEventManager(sdk.NewEventManager())
+				supply := bank.GetSupply(ctx, denom).Amount
+				k.BeforeEpochStart(ctx, "day", epochNumber)
+				require.Equal(t, minted, bank.GetSupply(ctx, denom).Amount.Sub(supply).Int64(), "epoch %d", epochNumber)
+
+				for _, event := range ctx.EventManager().ABCIEvents() {
+					if parsed, err := sdk.ParseTypedEvent(event); err == nil {
+						if clamp, ok := parsed.(*types.EventMintClamped); ok {
+							require.Equal(t, epochNumber, clamp.EpochNumber)
+							require.Equal(t, math.LegacyNewDec(100), clamp.EpochProvision)
+							require.Equal(t, math.NewInt(minted), clamp.Headroom)
+							clamped = true
+						}
+					}
+				}
+
+				_, broken := keeper.MaxSupplyInvariant(k)(ctx)
+				require.Equal(t, tc.supply > tc.maxSupply && tc.maxSupply > 0, broken)
+			}
+			require.Equal(t, tc.clamped, clamped)
+
+			// inflation is switched off permanently once the max supply is
+			// reached, without counting skipped epochs
+			reached := tc.maxSupply > 0
+			require.Equal(t, reached, k.GetMaxSupplyReached(ctx))
+			require.Equal(t, !reached, k.GetParams(ctx).EnableInflation)
+			require.Zero(t, k.GetSkippedEpochs(ctx))
+			if reached {
+				provision, err := k.GetEpochMintProvision(ctx)
+				require.NoError(t, err)
+				require.True(t, provision.IsZero())
+			}
+		})
+	}
+}
+
+func TestMintAndAllocateInflationMaxSupply(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+
+	params := k.GetParams(ctx)
+	params.MaxSupply = math.NewInt(100)
+	require.NoError(t, k.SetParams(ctx, params))
+	denom := params.MintDenom
+
+	// a retried epoch cannot mint beyond the max supply either
+	_, err := k.MintAndAllocateInflation(ctx, sdk.NewInt64Coin(denom, 101), params.InflationDistribution)
+	require.ErrorIs(t, err, types.ErrMaxSupplyReached)
+	require.True(t, bank.GetSupply(ctx, denom).IsZero())
+
+	_, err = k.MintAndAllocateInflation(ctx, sdk.NewInt64Coin(denom, 100), params.InflationDistribution)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), bank.GetSupply(ctx, denom).Amount.Int64())
+}
+
+func TestBeforeEpochStartDynamicInflation(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	staking := keepertest.NewStakingKeeper(25, 100)
+	k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, nil, nil, staking)
+
+	params := k.GetParams(ctx)
+	params.DynamicInflation = &types.DynamicInflation{
+		TargetBondedRatio:  math.LegacyMustNewDecFromStr("0.5"),
+		MinMultiplier:      math.LegacyMustNewDecFromStr("0.5"),
+		MaxMultiplier:      math.LegacyMustNewDecFromStr("1.15"),
+		MaxChangePerPeriod: math.LegacyMustNewDecFromStr("0.4"),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	denom := params.MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 4)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(4000)),
+		sdk.NewDecCoin(denom, math.NewInt(4000)),
+	}))
+
+	mint := func(epochNumber int64) int64 {
+		supply := bank.GetSupply(ctx, denom).Amount
+		k.BeforeEpochStart(ctx, "day", epochNumber)
+		return bank.GetSupply(ctx, denom).Amount.Sub(supply).Int64()
+	}
+
+	// below the target bonded ratio the provision rises up to the max
+	// multiplier
+	for epochNumber, minted := range []int64{1050, 1100, 1150, 1150} {
+		require.Equal(t, minted, mint(int64(epochNumber+1)), "epoch %d", epochNumber+1)
+	}
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.15"), k.GetMultiplier(ctx))
+
+	// above the target bonded ratio it falls
+	staking.Bonded = math.NewInt(100)
+	require.Equal(t, int64(1050), mint(5))
+
+	// without dynamic inflation the multiplier is kept but not applied
+	params.DynamicInflation = nil
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, int64(1000), mint(6))
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.05"), k.GetMultiplier(ctx))
+}
+
+func TestBeforeEpochStartDynamicInflationWithoutStaking(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+
+	params := k.GetParams(ctx)
+	params.DynamicInflation = &types.DynamicInflation{
+		TargetBondedRatio:  math.LegacyMustNewDecFromStr("0.5"),
+		MinMultiplier:      math.LegacyZeroDec(),
+		MaxMultiplier:      math.LegacyNewDec(2),
+		MaxChangePerPeriod: math.LegacyOneDec(),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetMultiplier(ctx, math.LegacyMustNewDecFromStr("1.5"))
+	denom := params.MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 1)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{sdk.NewDecCoin(denom, math.NewInt(100))}))
+
+	// the multiplier is kept if the bonded ratio is unavailable
+	k.BeforeEpochStart(ctx, "day", 1)
+	require.Equal(t, int64(150), bank.GetSupply(ctx, denom).Amount.Int64())
+	require.Equal(t, math.LegacyMustNewDecFromStr("1.5"), k.GetMultiplier(ctx))
+}
+
+func TestHooksEpochIdentifiersInUse(t *testing.T) {
+	k, ctx := keepertest.InflationKeeper(t)
+	k.SetEpochIdentifier(ctx, "week")
+	require.Equal(t, []string{"week"}, k.Hooks().EpochIdentifiersInUse(ctx))
+}
@@ -17,72 +17,99 @@
 package keeper
 
 import (
-	"fmt"
-
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
 
-// AllocateInflation allocates coins from the inflation to external
-// modules according to allocation proportions:
-//   - staking rewards -> sdk `auth` module fee collector
-//   - usage incentives -> `x/incentives` module
-//   - community pool -> `sdk `distr` module community pool
-func (k Keeper) AllocateInflation(
+// MintAndAllocateInflation mints the coin and allocates it together with the
+// carried remainder of the same denom according to the distribution. The
+// coins no share receives are handled by the remainder policy and returned.
+// Coins of the mint denom are not minted beyond the max supply. Some
+// recipients may have been paid when an error is returned, so the caller has
+// to discard the state changes on failure.
+func (k Keeper) MintAndAllocateInflation(
 	ctx sdk.Context,
 	mintedCoin sdk.Coin,
-	params types.Params,
-) (
-	validatorsCoins sdk.Coins,
-	otherCoins []sdk.Coins,
-	// staking, incentives, communityPool sdk.Coins,
-	err error,
-) {
-	distribution := params.InflationDistribution
-
-	// Allocate validators rewards
-	validatorsCoins = sdk.Coins{k.GetProportions(ctx, mintedCoin, distribution.ValidatorsShare)}
-
-	// if err := k.bankKeeper.SendCoinsFromModuleToModule(
-	// 	ctx,
-	// 	types.ModuleName,
-	// 	k.feeCollectorName,
-	// 	staking,
-	// ); err != nil {
-	// 	return nil, nil, nil, err
-	// }
-
-	// // Allocate usage incentives to incentives module account
-	// incentives = sdk.Coins{k.GetProportions(ctx, mintedCoin, distribution.UsageIncentives)}
-
-	// if err = k.bankKeeper.SendCoinsFromModuleToModule(
-	// 	ctx,
-	// 	types.ModuleName,
-	// 	incentivestypes.ModuleName,
-	// 	incentives,
-	// ); err != nil {
-	// 	return nil, nil, nil, err
-	// }
-
-	// // Allocate community pool amount (remaining module balance) to community
-	// // pool address
-	// moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
-	// inflationBalance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
-
-	// err = k.distrKeeper.FundCommunityPool(
-	// 	ctx,
-	// 	inflationBalance,
-	// 	moduleAddr,
-	// )
-	// if err != nil {
-	// 	return nil, nil, nil, err
-	// }
-
-	// return staking, incentives, communityPool, nil
-
-	return validatorsCoins, otherCoins, nil
+	distribution types.InflationDistribution,
+) (sdk.Coin, error) {
+	params := k.GetParams(ctx)
+	if mintedCoin.Denom == params.MintDenom {
+		if headroom, limited := k.getSupplyHeadroom(ctx, params); limited && headroom.LT(math.LegacyNewDecFromInt(mintedCoin.Amount)) {
+			return sdk.Coin{}, errorsmod.Wrapf(
+				types.ErrMaxSupplyReached,
+				"minting %s exceeds the headroom of %s below the max supply", mintedCoin, headroom.TruncateInt(),
+			)
+		}
+	}
+
+	if err := k.MintCoins(ctx, mintedCoin); err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(err, "failed to mint %s", mintedCoin)
+	}
+
+	available := mintedCoin.AddAmount(k.GetRemainder(ctx).AmountOf(mintedCoin.Denom))
+	remainder, err := k.AllocateInflation(ctx, available, distribution)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.settleRemainder(ctx, remainder, params.RemainderPolicy); err != nil {
+		return sdk.Coin{}, err
+	}
+	return remainder, nil
+}
+
+// AllocateInflation allocates coins from the inflation module account
+// according to the distribution and returns the remainder no share received:
+//   - validators share -> sdk `auth` module fee collector
+//   - other shares -> the recipient of each share
+func (k Keeper) AllocateInflation(
+	ctx sdk.Context,
+	coin sdk.Coin,
+	distribution types.InflationDistribution,
+) (sdk.Coin, error) {
+	remainder := coin
+
+	validators := k.GetProportions(ctx, coin, distribution.ValidatorsShare)
+	if validators.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.ModuleName,
+			authtypes.FeeCollectorName,
+			sdk.Coins{validators},
+		); err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send %s to validators", validators)
+		}
+		k.emitAllocation(ctx, types.RecipientValidators, validators)
+		remainder = remainder.Sub(validators)
+	}
+
+	for _, share := range distribution.OtherShares {
+		other := k.GetProportions(ctx, coin, share.Share)
+		if !other.IsPositive() {
+			continue
+		}
+		if err := k.SendShare(ctx, *share, sdk.Coins{other}); err != nil {
+			return sdk.Coin{}, errorsmod.Wrapf(err, "failed to send %s to %s", other, share.Recipient())
+		}
+		k.emitAllocation(ctx, share.Recipient(), other)
+		remainder = remainder.Sub(other)
+	}
+
+	return remainder, nil
+}
+
+// emitAllocation emits the event of coins allocated to a recipient
+func (k Keeper) emitAllocation(ctx sdk.Context, recipient string, coin sdk.Coin) {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAllocateInflation{
+		Recipient: recipient,
+		Amount:    coin,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "error", err.Error())
+	}
 }
 
 // GetProportion calculates the proportion of coins that is to be
@@ -98,6 +125,28 @@ func (k Keeper) GetProportions(
 	}
 }
 
+// SendShare sends the coins of an inflation share from the module account to
+// the recipient of the share
+func (k Keeper) SendShare(ctx sdk.Context, share types.InflationShare, coins sdk.Coins) error {
+	switch share.Kind {
+	case types.RecipientKindModule:
+		if k.accountKeeper.GetModuleAddress(share.ModuleName) == nil {
+			return errorsmod.Wrapf(types.ErrInvalidDistribution, "module account %s not found", share.ModuleName)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, share.ModuleName, coins)
+	case types.RecipientKindCommunityPool:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, authtypes.NewModuleAddress(types.ModuleName))
+	case types.RecipientKindBurn:
+		return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	default:
+		addr, err := sdk.AccAddressFromBech32(share.Address)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins)
+	}
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
@@ -105,25 +154,169 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 	return k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
 }
 
-func (k Keeper) SetInflationDistribution(ctx sdk.Context, dist types.InflationDistribution) error {
-	store := ctx.KVStore(k.storeKey)
-	b, err := k.cdc.Marshal(&dist)
-	if err != nil {
+// GetInflationDistribution returns the distribution of the minted coins,
+// which is part of the params
+func (k Keeper) GetInflationDistribution(ctx sdk.Context) types.InflationDistribution {
+	return k.GetParams(ctx).InflationDistribution
+}
+
+// SetInflationDistribution validates the distribution of the minted coins and
+// stores it in the params
+func (k Keeper) SetInflationDistribution(ctx sdk.Context, distribution types.InflationDistribution) error {
+	if err := distribution.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDistribution, err.Error())
+	}
+	if err := k.ValidateRecipients(distribution); err != nil {
 		return err
 	}
-	store.Set(types.InflationDistributionKey, b)
-	return nil
+
+	params := k.GetParams(ctx)
+	params.InflationDistribution = distribution
+	return k.SetParams(ctx, params)
 }
 
-func (k Keeper) GetInflationDistribution(ctx sdk.Context) (dist types.InflationDistribution, found bool) {
-	store := ctx.KVStore(k.storeKey)
-	b := store.Get(types.InflationDistributionKey)
-	if b == nil {
-		return dist, false
+// GetPeriodMintProvision returns the amount minted in the given period. It is
+// computed by the emission schedule of the params if set, otherwise it is
+// taken from the period mint provisions, which end after their last period.
+func (k Keeper) GetPeriodMintProvision(ctx sdk.Context, period uint64) (math.LegacyDec, error) {
+	if schedule := k.GetParams(ctx).EmissionSchedule; schedule != nil {
+		return types.CalculatePeriodMintProvision(*schedule, period), nil
 	}
-	err := k.cdc.Unmarshal(b, &dist)
+
+	periodMintProvisions, err := k.GetPeriodMintProvisions(ctx)
 	if err != nil {
-		panic(fmt.Sprintf("failed to unmarshal inflation distribution: %s", err))
+		return math.LegacyDec{}, err
+	}
+	if period >= uint64(len(periodMintProvisions)) {
+		return math.LegacyZeroDec(), nil
 	}
-	return dist, true
+	return periodMintProvisions[period].Amount, nil
+}
+
+// GetEpochMintProvision returns the amount minted in an epoch of the current
+// period, limited by the max supply and zero once all periods have passed or
+// the max supply was reached
+func (k Keeper) GetEpochMintProvision(ctx sdk.Context) (math.LegacyDec, error) {
+	if k.GetMaxSupplyReached(ctx) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	params := k.GetParams(ctx)
+	epochMintProvision, err := k.getScheduledEpochMintProvision(ctx, params)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if headroom, limited := k.getSupplyHeadroom(ctx, params); limited {
+		epochMintProvision = math.LegacyMinDec(epochMintProvision, headroom)
+	}
+	return epochMintProvision, nil
+}
+
+// getScheduledEpochMintProvision returns the amount minted in an epoch of the
+// current period scaled by the multiplier of dynamic inflation, without the
+// limit of the max supply
+func (k Keeper) getScheduledEpochMintProvision(ctx sdk.Context, params types.Params) (math.LegacyDec, error) {
+	period := k.GetPeriod(ctx)
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	if epochsPerPeriod <= 0 {
+		return math.LegacyZeroDec(), nil
+	}
+
+	var epochMintProvision math.LegacyDec
+	if params.EmissionSchedule != nil {
+		epochMintProvision = types.CalculatePeriodMintProvision(*params.EmissionSchedule, period).QuoInt64(epochsPerPeriod)
+	} else {
+		periodMintProvisions, err := k.GetPeriodMintProvisions(ctx)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+		epochMintProvision = types.CalculateEpochMintProvision(periodMintProvisions, period, epochsPerPeriod)
+	}
+	return epochMintProvision.Mul(k.getMultiplier(ctx, params)), nil
+}
+
+// getSupplyHeadroom returns the amount that can be minted before the supply
+// of the mint denom reaches the max supply, if a max supply is set
+func (k Keeper) getSupplyHeadroom(ctx sdk.Context, params types.Params) (math.LegacyDec, bool) {
+	if params.MaxSupply.IsNil() || !params.MaxSupply.IsPositive() {
+		return math.LegacyDec{}, false
+	}
+
+	headroom := params.MaxSupply.Sub(k.bankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	return math.LegacyNewDecFromInt(math.MaxInt(headroom, math.ZeroInt())), true
+}
+
+// scheduleEnded returns true if the period passed the end of the period mint
+// provisions, which are only used without an emission schedule
+func (k Keeper) scheduleEnded(ctx sdk.Context, period uint64) bool {
+	if k.GetParams(ctx).EmissionSchedule != nil {
+		return false
+	}
+
+	periodMintProvisions, err := k.GetPeriodMintProvisions(ctx)
+	return err == nil && period >= uint64(len(periodMintProvisions))
+}
+
+// PreviewEmission returns the provisions of the given number of periods
+// starting at the current period. The provisions are scaled by the current
+// multiplier of dynamic inflation and limited by the max supply as if they
+// were minted in full.
+func (k Keeper) PreviewEmission(ctx sdk.Context, periods uint32) ([]types.PeriodProvision, error) {
+	params := k.GetParams(ctx)
+	epochsPerPeriod := k.GetEpochsPerPeriod(ctx)
+	headroom, limited := k.getSupplyHeadroom(ctx, params)
+	multiplier := k.getMultiplier(ctx, params)
+
+	provisions := make([]types.PeriodProvision, 0, periods)
+	for period := k.GetPeriod(ctx); len(provisions) < int(periods); period++ {
+		periodMintProvision, err := k.GetPeriodMintProvision(ctx, period)
+		if err != nil {
+			return nil, err
+		}
+		periodMintProvision = periodMintProvision.Mul(multiplier)
+		if limited {
+			periodMintProvision = math.LegacyMinDec(periodMintProvision, headroom)
+			headroom = headroom.Sub(periodMintProvision)
+		}
+
+		epochMintProvision := math.LegacyZeroDec()
+		if epochsPerPeriod > 0 {
+			epochMintProvision = periodMintProvision.QuoInt64(epochsPerPeriod)
+		}
+
+		provisions = append(provisions, types.PeriodProvision{
+			Period:          period,
+			PeriodProvision: sdk.NewDecCoinFromDec(params.MintDenom, periodMintProvision),
+			EpochProvision:  sdk.NewDecCoinFromDec(params.MintDenom, epochMintProvision),
+		})
+	}
+	return provisions, nil
+}
+
+// GetCirculatingSupply returns the total supply of the given denom minus the
+// minted coins the module has not distributed yet
+func (k Keeper) GetCirculatingSupply(ctx sdk.Context, denom string) math.LegacyDec {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	undistributed := k.bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), denom).Amount
+	return math.LegacyNewDecFromInt(supply.Sub(undistributed))
+}
+
+// GetInflationRate returns the annualized inflation rate of the current
+// period in percent of the total supply of the given denom. A period lasts
+// one year, so the rate is the mint provision of all epochs of the period
+// over the supply.
+func (k Keeper) GetInflationRate(ctx sdk.Context, denom string) (math.LegacyDec, error) {
+	epochMintProvision, err := k.GetEpochMintProvision(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if !supply.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	periodMintProvision := epochMintProvision.MulInt64(k.GetEpochsPerPeriod(ctx))
+	return periodMintProvision.QuoInt(supply).MulInt64(100), nil
 }
@@ -0,0 +1,123 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// RegisterInvariants registers the inflation module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "distribution-shares", DistributionSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "period-range", PeriodRangeInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "max-supply", MaxSupplyInvariant(k))
+}
+
+// AllInvariants runs all invariants of the inflation module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ModuleBalanceInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := DistributionSharesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := PeriodRangeInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return MaxSupplyInvariant(k)(ctx)
+	}
+}
+
+// ModuleBalanceInvariant checks that the inflation module account holds
+// exactly the carried remainder, as everything else minted in an epoch is
+// allocated in the same block
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+		remainder := k.GetRemainder(ctx)
+		broken := !balance.Equal(remainder)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("\tinflation module account balance: %s, carried remainder: %s\n", balance, remainder),
+		), broken
+	}
+}
+
+// DistributionSharesInvariant checks that the shares of the inflation
+// distribution are valid and sum up to at most 1
+func DistributionSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if err := k.GetInflationDistribution(ctx).ValidateShares(); err != nil {
+			broken = true
+			msg = fmt.Sprintf("\tinflation distribution: %s\n", err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "distribution-shares", msg), broken
+	}
+}
+
+// PeriodRangeInvariant checks that the current period does not pass the end of
+// the period mint provisions by more than one period, if they are used
+func PeriodRangeInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		period := k.GetPeriod(ctx)
+		provisions, err := k.GetPeriodMintProvisions(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(
+				types.ModuleName, "period-range",
+				fmt.Sprintf("\tfailed to get period mint provisions: %s\n", err),
+			), true
+		}
+
+		broken := k.GetParams(ctx).EmissionSchedule == nil &&
+			len(provisions) > 0 && period > uint64(len(provisions))
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "period-range",
+			fmt.Sprintf("\tperiod %d, period mint provisions %d\n", period, len(provisions)),
+		), broken
+	}
+}
+
+// MaxSupplyInvariant checks that the supply of the mint denom does not exceed
+// the max supply, if one is set
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params := k.GetParams(ctx)
+		supply := k.bankKeeper.GetSupply(ctx, params.MintDenom)
+		limited := !params.MaxSupply.IsNil() && params.MaxSupply.IsPositive()
+		broken := limited && supply.Amount.GT(params.MaxSupply)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "max-supply",
+			fmt.Sprintf("\tsupply: %s, max supply: %s\n", supply, params.MaxSupply),
+		), broken
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/x/inflation/keeper"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestDistributionSharesInvariant(t *testing.T) {
+	testCases := []struct {
+		name         string
+		distribution types.InflationDistribution
+		expBroken    bool
+	}{
+		{
+			name:         "default",
+			distribution: types.DefaultInflationDistribution(),
+		},
+		{
+			name: "less than one",
+			distribution: types.InflationDistribution{
+				ValidatorsShare: math.LegacyNewDecWithPrec(5, 1),
+				OtherShares: []*types.InflationShare{
+					{Name: "dao", Address: sdk.AccAddress("dao").String(), Share: math.LegacyNewDecWithPrec(3, 1)},
+				},
+			},
+		},
+		{
+			name: "more than one",
+			distribution: types.InflationDistribution{
+				ValidatorsShare: math.LegacyNewDecWithPrec(8, 1),
+				OtherShares: []*types.InflationShare{
+					{Name: "dao", Address: sdk.AccAddress("dao").String(), Share: math.LegacyNewDecWithPrec(3, 1)},
+				},
+			},
+			expBroken: true,
+		},
+		{
+			name: "negative share",
+			distribution: types.InflationDistribution{
+				ValidatorsShare: math.LegacyOneDec(),
+				OtherShares: []*types.InflationShare{
+					{Name: "dao", Address: sdk.AccAddress("dao").String(), Share: math.LegacyNewDecWithPrec(-1, 1)},
+				},
+			},
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := keepertest.InflationKeeper(t)
+			params := k.GetParams(ctx)
+			params.InflationDistribution = tc.distribution
+			require.NoError(t, k.SetParams(ctx, params))
+
+			msg, broken := keeper.DistributionSharesInvariant(k)(ctx)
+			require.Equal(t, tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func TestPeriodRangeInvariant(t *testing.T) {
+	k, ctx := keepertest.InflationKeeper(t)
+
+	// no schedule set
+	_, broken := keeper.PeriodRangeInvariant(k)(ctx)
+	require.False(t, broken)
+
+	provisions := sdk.NewDecCoins(sdk.NewDecCoin("gnet", math.NewInt(100)))
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, provisions))
+	_, broken = keeper.PeriodRangeInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the period after the last one has no provision
+	k.SetPeriod(ctx, 1)
+	_, broken = keeper.PeriodRangeInvariant(k)(ctx)
+	require.False(t, broken)
+
+	k.SetPeriod(ctx, 2)
+	_, broken = keeper.PeriodRangeInvariant(k)(ctx)
+	require.True(t, broken)
+
+	// an emission schedule has no end
+	params := k.GetParams(ctx)
+	params.EmissionSchedule = &types.EmissionSchedule{
+		InitialProvision: math.LegacyNewDec(100),
+		DecayFactor:      math.LegacyOneDec(),
+		FloorProvision:   math.LegacyZeroDec(),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	_, broken = keeper.PeriodRangeInvariant(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestModuleBalanceInvariant(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	distr := keepertest.NewDistrKeeper(bank)
+	k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, distr, nil, nil)
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+	_, broken := keeper.ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// the module account holds the carried remainder
+	remainder := sdk.NewCoins(sdk.NewInt64Coin("gnet", 7))
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, remainder))
+	k.SetRemainder(ctx, remainder)
+	_, broken = keeper.ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// coins sent to the module account break the invariant until they are
+	// swept, the app blocks the module account from receiving funds
+	sent := sdk.NewCoins(sdk.NewInt64Coin("gnet", 100))
+	require.NoError(t, bank.MintCoins(ctx, "minter", sent))
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, "minter", moduleAddr, sent))
+	_, broken = keeper.ModuleBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+
+	swept, err := k.SweepStrandedBalance(ctx, types.RecipientCommunityPool)
+	require.NoError(t, err)
+	require.Equal(t, sent, swept)
+	require.Equal(t, sent, distr.CommunityPool(ctx))
+	require.Equal(t, remainder, bank.GetAllBalances(ctx, moduleAddr))
+	_, broken = keeper.ModuleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+}
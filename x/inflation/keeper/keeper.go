@@ -19,6 +19,7 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -37,8 +38,11 @@ type (
 		// should be the x/gov module account.
 		authority string
 
-		bankKeeper  types.BankKeeper
-		distrKeeper types.DistrKeeper
+		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
+		distrKeeper   types.DistrKeeper
+		epochsKeeper  types.EpochsKeeper
+		stakingKeeper types.StakingKeeper
 	}
 )
 
@@ -48,8 +52,11 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	authority string,
 
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
+	epochsKeeper types.EpochsKeeper,
+	stakingKeeper types.StakingKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -61,8 +68,11 @@ func NewKeeper(
 		memKey:    memKey,
 		authority: authority,
 
-		bankKeeper:  bankKeeper,
-		distrKeeper: distrKeeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		epochsKeeper:  epochsKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 }
 
@@ -71,6 +81,38 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// ValidateMintDenom checks that the denom is registered in the bank denom
+// metadata
+func (k Keeper) ValidateMintDenom(ctx sdk.Context, denom string) error {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found {
+		return errorsmod.Wrapf(types.ErrInvalidMintDenom, "denom %s has no bank metadata", denom)
+	}
+	return nil
+}
+
+// ValidateEpochIdentifier checks that the epochs module defines the epoch
+// identifier
+func (k Keeper) ValidateEpochIdentifier(ctx sdk.Context, identifier string) error {
+	if _, found := k.epochsKeeper.GetEpochInfo(ctx, identifier); !found {
+		return errorsmod.Wrapf(types.ErrInvalidEpochIdentifier, "epoch identifier %s not found", identifier)
+	}
+	return nil
+}
+
+// ValidateRecipients checks that the module accounts receiving shares of the
+// distribution exist
+func (k Keeper) ValidateRecipients(distribution types.InflationDistribution) error {
+	for _, share := range distribution.OtherShares {
+		if share.Kind != types.RecipientKindModule {
+			continue
+		}
+		if k.accountKeeper.GetModuleAddress(share.ModuleName) == nil {
+			return errorsmod.Wrapf(types.ErrInvalidDistribution, "module account %s not found", share.ModuleName)
+		}
+	}
+	return nil
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
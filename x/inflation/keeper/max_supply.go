@@ -0,0 +1,67 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// GetMaxSupplyReached returns true if inflation was switched off because the
+// supply reached the max supply
+func (k Keeper) GetMaxSupplyReached(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.MaxSupplyReachedKey)
+}
+
+// SetMaxSupplyReached stores whether the supply reached the max supply
+func (k Keeper) SetMaxSupplyReached(ctx sdk.Context, reached bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !reached {
+		store.Delete(types.MaxSupplyReachedKey)
+		return
+	}
+	store.Set(types.MaxSupplyReachedKey, []byte{1})
+}
+
+// reachMaxSupply switches inflation off permanently once the supply of the
+// mint denom reached the max supply
+func (k Keeper) reachMaxSupply(ctx sdk.Context, params types.Params, epochNumber int64) {
+	k.SetMaxSupplyReached(ctx, true)
+
+	params.EnableInflation = false
+	if err := k.SetParams(ctx, params); err != nil {
+		k.Logger(ctx).Error("failed to disable inflation", "error", err.Error())
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, params.MintDenom)
+	k.Logger(ctx).Info(
+		"max supply reached, inflation is switched off",
+		"epoch", epochNumber,
+		"supply", supply.String(),
+		"max-supply", params.MaxSupply.String(),
+	)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMaxSupplyReached{
+		EpochNumber: epochNumber,
+		Supply:      supply,
+		MaxSupply:   params.MaxSupply,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit event", "error", err.Error())
+	}
+}
@@ -0,0 +1,53 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func (k msgServer) AddInflationShare(goCtx context.Context, req *types.MsgAddInflationShare) (*types.MsgAddInflationShareResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	distribution := k.GetInflationDistribution(ctx)
+	if _, found := distribution.FindShare(req.Share.Recipient()); found {
+		return nil, errorsmod.Wrapf(types.ErrShareExists, "recipient %s", req.Share.Recipient())
+	}
+
+	share := req.Share
+	distribution.OtherShares = append(distribution.OtherShares, &share)
+	if err := k.SetInflationDistribution(ctx, distribution); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAddInflationShare{
+		Share:      share,
+		TotalShare: distribution.TotalShare(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddInflationShareResponse{}, nil
+}
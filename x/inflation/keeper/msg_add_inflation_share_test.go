@@ -0,0 +1,101 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestMsgAddInflationShare(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	dao := sample.AccAddress()
+
+	// validators receive 90% so that 10% can be shared
+	require.NoError(t, k.SetInflationDistribution(ctx, types.InflationDistribution{
+		ValidatorsShare: math.LegacyNewDecWithPrec(9, 1),
+	}))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgAddInflationShare
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgAddInflationShare{
+				Authority: "invalid",
+				Share:     types.InflationShare{Name: "dao", Address: dao, Share: math.LegacyNewDecWithPrec(1, 1)},
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "shares sum above one",
+			input: &types.MsgAddInflationShare{
+				Authority: k.GetAuthority(),
+				Share:     types.InflationShare{Name: "dao", Address: dao, Share: math.LegacyNewDecWithPrec(2, 1)},
+			},
+			expErr:    true,
+			expErrMsg: "invalid inflation distribution",
+		},
+		{
+			name: "all good",
+			input: &types.MsgAddInflationShare{
+				Authority: k.GetAuthority(),
+				Share:     types.InflationShare{Name: "dao", Address: dao, Share: math.LegacyNewDecWithPrec(1, 1)},
+			},
+			expErr: false,
+		},
+		{
+			name: "already exists",
+			input: &types.MsgAddInflationShare{
+				Authority: k.GetAuthority(),
+				Share:     types.InflationShare{Name: "dao", Address: dao, Share: math.LegacyZeroDec()},
+			},
+			expErr:    true,
+			expErrMsg: "inflation share already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.AddInflationShare(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	distribution := k.GetInflationDistribution(ctx)
+	require.Len(t, distribution.OtherShares, 1)
+	require.Equal(t, dao, distribution.OtherShares[0].Address)
+	require.True(t, distribution.TotalShare().Equal(math.LegacyOneDec()))
+
+	event, ok := lastTypedEvent(t, ctx).(*types.EventAddInflationShare)
+	require.True(t, ok)
+	require.True(t, distribution.OtherShares[0].Equal(&event.Share))
+	require.True(t, event.TotalShare.Equal(math.LegacyOneDec()))
+}
@@ -0,0 +1,103 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestMsgRedirectStrandedBalance(t *testing.T) {
+	k, ms, ctx, bank := setupMsgServerWithBank(t)
+	account := sample.AccAddress()
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgRedirectStrandedBalance
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgRedirectStrandedBalance{
+				Authority: "invalid",
+				Recipient: account,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "no balance",
+			input: &types.MsgRedirectStrandedBalance{
+				Authority: k.GetAuthority(),
+				Recipient: account,
+			},
+			expErr:    true,
+			expErrMsg: "no stranded balance",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RedirectStrandedBalance(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the carried remainder is not stranded
+	remainder := sdk.NewCoins(sdk.NewInt64Coin("gnet", 7))
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, remainder))
+	k.SetRemainder(ctx, remainder)
+	_, err := ms.RedirectStrandedBalance(ctx, &types.MsgRedirectStrandedBalance{
+		Authority: k.GetAuthority(),
+		Recipient: account,
+	})
+	require.ErrorIs(t, err, types.ErrNoStrandedBalance)
+
+	stranded := sdk.NewCoins(sdk.NewInt64Coin("gnet", 100), sdk.NewInt64Coin("other", 5))
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, stranded))
+
+	_, err = ms.RedirectStrandedBalance(ctx, &types.MsgRedirectStrandedBalance{
+		Authority: k.GetAuthority(),
+		Recipient: types.RecipientModulePrefix + "unknown",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidDistribution)
+
+	res, err := ms.RedirectStrandedBalance(ctx, &types.MsgRedirectStrandedBalance{
+		Authority: k.GetAuthority(),
+		Recipient: account,
+	})
+	require.NoError(t, err)
+	require.Equal(t, stranded, res.Amount)
+	require.Equal(t, stranded, bank.GetAllBalances(ctx, sdk.MustAccAddressFromBech32(account)))
+
+	event, ok := lastTypedEvent(t, ctx).(*types.EventRedirectStrandedBalance)
+	require.True(t, ok)
+	require.Equal(t, account, event.Recipient)
+	require.Equal(t, stranded, event.Amount)
+	require.Equal(t, remainder, bank.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName)))
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestMsgRemoveInflationShare(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	dao, faucet := sample.AccAddress(), sample.AccAddress()
+
+	removed := types.InflationShare{Name: "dao", Address: dao, Share: math.LegacyNewDecWithPrec(1, 1)}
+	require.NoError(t, k.SetInflationDistribution(ctx, types.InflationDistribution{
+		ValidatorsShare: math.LegacyNewDecWithPrec(8, 1),
+		OtherShares: []*types.InflationShare{
+			&removed,
+			{Name: "faucet", Address: faucet, Share: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	}))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgRemoveInflationShare
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgRemoveInflationShare{
+				Authority: "invalid",
+				Recipient: dao,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "all good",
+			input: &types.MsgRemoveInflationShare{
+				Authority: k.GetAuthority(),
+				Recipient: dao,
+			},
+			expErr: false,
+		},
+		{
+			name: "not found",
+			input: &types.MsgRemoveInflationShare{
+				Authority: k.GetAuthority(),
+				Recipient: dao,
+			},
+			expErr:    true,
+			expErrMsg: "inflation share not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RemoveInflationShare(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	distribution := k.GetInflationDistribution(ctx)
+	require.Len(t, distribution.OtherShares, 1)
+	require.Equal(t, faucet, distribution.OtherShares[0].Address)
+
+	event, ok := lastTypedEvent(t, ctx).(*types.EventRemoveInflationShare)
+	require.True(t, ok)
+	require.True(t, removed.Equal(&event.Share))
+	require.True(t, event.TotalShare.Equal(math.LegacyNewDecWithPrec(9, 1)))
+}
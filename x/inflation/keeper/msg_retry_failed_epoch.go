@@ -0,0 +1,71 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func (k msgServer) RetryFailedEpoch(goCtx context.Context, req *types.MsgRetryFailedEpoch) (*types.MsgRetryFailedEpochResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	failed, found := k.GetFailedEpoch(ctx, req.EpochNumber)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFailedEpochNotFound, "epoch %d", req.EpochNumber)
+	}
+
+	// the whole amount goes to the recipient, if one is given
+	distribution := k.GetInflationDistribution(ctx)
+	if req.Recipient != "" {
+		share := types.NewInflationShare(req.Recipient, "", math.LegacyOneDec())
+		distribution = types.InflationDistribution{
+			ValidatorsShare: math.LegacyZeroDec(),
+			OtherShares:     []*types.InflationShare{&share},
+		}
+		if err := distribution.Validate(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidDistribution, err.Error())
+		}
+		if err := k.ValidateRecipients(distribution); err != nil {
+			return nil, err
+		}
+	}
+
+	// a failed message discards all state changes, so no cached context is
+	// needed here
+	if _, err := k.MintAndAllocateInflation(ctx, failed.Amount, distribution); err != nil {
+		return nil, err
+	}
+	k.DeleteFailedEpoch(ctx, req.EpochNumber)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRetryFailedEpoch{
+		FailedEpoch: failed,
+		Recipient:   req.Recipient,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetryFailedEpochResponse{}, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestMsgRetryFailedEpoch(t *testing.T) {
+	k, ms, ctx, bank := setupMsgServerWithBank(t)
+	account := sample.AccAddress()
+
+	require.NoError(t, k.SetInflationDistribution(ctx, types.InflationDistribution{
+		ValidatorsShare: math.LegacyNewDecWithPrec(6, 1),
+		OtherShares: []*types.InflationShare{
+			{Name: "account", Address: account, Share: math.LegacyNewDecWithPrec(4, 1)},
+		},
+	}))
+	denom := k.GetParams(ctx).MintDenom
+	for _, epochNumber := range []int64{1, 2} {
+		k.SetFailedEpoch(ctx, types.FailedEpoch{
+			EpochNumber:     epochNumber,
+			EpochIdentifier: "day",
+			Amount:          sdk.NewInt64Coin(denom, 1000),
+			Reason:          "failed",
+		})
+	}
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgRetryFailedEpoch
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgRetryFailedEpoch{
+				Authority:   "invalid",
+				EpochNumber: 1,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "not found",
+			input: &types.MsgRetryFailedEpoch{
+				Authority:   k.GetAuthority(),
+				EpochNumber: 3,
+			},
+			expErr:    true,
+			expErrMsg: "failed epoch not found",
+		},
+		{
+			name: "unknown module",
+			input: &types.MsgRetryFailedEpoch{
+				Authority:   k.GetAuthority(),
+				EpochNumber: 1,
+				Recipient:   types.RecipientModulePrefix + "unknown",
+			},
+			expErr:    true,
+			expErrMsg: "module account unknown not found",
+		},
+		{
+			name: "distribution",
+			input: &types.MsgRetryFailedEpoch{
+				Authority:   k.GetAuthority(),
+				EpochNumber: 1,
+			},
+			expErr: false,
+		},
+		{
+			name: "already retried",
+			input: &types.MsgRetryFailedEpoch{
+				Authority:   k.GetAuthority(),
+				EpochNumber: 1,
+			},
+			expErr:    true,
+			expErrMsg: "failed epoch not found",
+		},
+		{
+			name: "recipient",
+			input: &types.MsgRetryFailedEpoch{
+				Authority:   k.GetAuthority(),
+				EpochNumber: 2,
+				Recipient:   types.RecipientCommunityPool,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RetryFailedEpoch(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	balance := func(addr sdk.AccAddress) math.Int {
+		return bank.GetBalance(ctx, addr, denom).Amount
+	}
+	require.Equal(t, math.NewInt(600), balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName)))
+	require.Equal(t, math.NewInt(400), balance(sdk.MustAccAddressFromBech32(account)))
+	require.Equal(t, math.NewInt(1000), balance(authtypes.NewModuleAddress(distrtypes.ModuleName)))
+	require.Equal(t, math.NewInt(2000), bank.GetSupply(ctx, denom).Amount)
+	require.Empty(t, k.AllFailedEpochs(ctx))
+
+	event, ok := lastTypedEvent(t, ctx).(*types.EventRetryFailedEpoch)
+	require.True(t, ok)
+	require.Equal(t, int64(2), event.FailedEpoch.EpochNumber)
+	require.Equal(t, types.RecipientCommunityPool, event.Recipient)
+}
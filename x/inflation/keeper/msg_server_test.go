@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
@@ -30,9 +31,27 @@ func setupMsgServer(t testing.TB) (keeper.Keeper, types.MsgServer, sdk.Context)
 	return k, keeper.NewMsgServerImpl(k), ctx
 }
 
+// setupMsgServerWithBank returns a msg server whose keeper mints and sends
+// coins with the returned bank keeper
+func setupMsgServerWithBank(t testing.TB) (keeper.Keeper, types.MsgServer, sdk.Context, *keepertest.BankKeeper) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, keepertest.NewDistrKeeper(bank), nil, nil)
+	return k, keeper.NewMsgServerImpl(k), ctx, bank
+}
+
 func TestMsgServer(t *testing.T) {
 	k, ms, ctx := setupMsgServer(t)
 	require.NotNil(t, ms)
 	require.NotNil(t, ctx)
 	require.NotEmpty(t, k)
 }
+
+// lastTypedEvent parses the last event emitted in the context
+func lastTypedEvent(t *testing.T, ctx sdk.Context) proto.Message {
+	events := ctx.EventManager().ABCIEvents()
+	require.NotEmpty(t, events)
+
+	event, err := sdk.ParseTypedEvent(events[len(events)-1])
+	require.NoError(t, err)
+	return event
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestMsgSetValidatorsShare(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	require.NoError(t, k.SetInflationDistribution(ctx, types.InflationDistribution{
+		ValidatorsShare: math.LegacyNewDecWithPrec(8, 1),
+		OtherShares: []*types.InflationShare{
+			{Name: "dao", Address: sample.AccAddress(), Share: math.LegacyNewDecWithPrec(2, 1)},
+		},
+	}))
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgSetValidatorsShare
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgSetValidatorsShare{
+				Authority:       "invalid",
+				ValidatorsShare: math.LegacyNewDecWithPrec(7, 1),
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "shares sum above one",
+			input: &types.MsgSetValidatorsShare{
+				Authority:       k.GetAuthority(),
+				ValidatorsShare: math.LegacyNewDecWithPrec(9, 1),
+			},
+			expErr:    true,
+			expErrMsg: "invalid inflation distribution",
+		},
+		{
+			name: "all good",
+			input: &types.MsgSetValidatorsShare{
+				Authority:       k.GetAuthority(),
+				ValidatorsShare: math.LegacyNewDecWithPrec(7, 1),
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.SetValidatorsShare(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	distribution := k.GetInflationDistribution(ctx)
+	require.True(t, distribution.ValidatorsShare.Equal(math.LegacyNewDecWithPrec(7, 1)))
+
+	event, ok := lastTypedEvent(t, ctx).(*types.EventSetValidatorsShare)
+	require.True(t, ok)
+	require.True(t, event.Before.Equal(math.LegacyNewDecWithPrec(8, 1)))
+	require.True(t, event.After.Equal(math.LegacyNewDecWithPrec(7, 1)))
+	require.True(t, event.TotalShare.Equal(math.LegacyNewDecWithPrec(9, 1)))
+}
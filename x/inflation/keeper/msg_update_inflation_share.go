@@ -0,0 +1,56 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func (k msgServer) UpdateInflationShare(goCtx context.Context, req *types.MsgUpdateInflationShare) (*types.MsgUpdateInflationShareResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	distribution := k.GetInflationDistribution(ctx)
+	i, found := distribution.FindShare(req.Share.Recipient())
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrShareNotFound, "recipient %s", req.Share.Recipient())
+	}
+
+	before := *distribution.OtherShares[i]
+	after := req.Share
+	distribution.OtherShares[i] = &after
+	if err := k.SetInflationDistribution(ctx, distribution); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateInflationShare{
+		Before:     before,
+		After:      after,
+		TotalShare: distribution.TotalShare(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateInflationShareResponse{}, nil
+}
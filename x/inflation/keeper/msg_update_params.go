@@ -30,10 +30,36 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
 	}
 
+	if err := k.ValidateRecipients(req.Params.InflationDistribution); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.GetMaxSupplyReached(ctx) && req.Params.EnableInflation {
+		return nil, errorsmod.Wrap(types.ErrMaxSupplyReached, "inflation cannot be enabled again")
+	}
+	if maxSupply := req.Params.MaxSupply; !maxSupply.IsNil() && maxSupply.IsPositive() {
+		if supply := k.bankKeeper.GetSupply(ctx, req.Params.MintDenom); supply.Amount.GT(maxSupply) {
+			return nil, errorsmod.Wrapf(types.ErrMaxSupplyReached, "max supply %s is below the supply %s", maxSupply, supply)
+		}
+	}
+
+	removesSchedule := k.GetParams(ctx).EmissionSchedule != nil && req.Params.EmissionSchedule == nil
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	// An emission schedule has no end, while the period mint provisions end
+	// after their last period. The period is clamped to it when switching back.
+	if removesSchedule {
+		provisions, err := k.GetPeriodMintProvisions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if end := uint64(len(provisions)); end > 0 && k.GetPeriod(ctx) > end {
+			k.SetPeriod(ctx, end)
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
@@ -17,9 +17,11 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Galactica-corp/galactica/x/inflation/keeper"
 	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
 
@@ -76,3 +78,60 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParamsMaxSupply(t *testing.T) {
+	k, ms, ctx, bank := setupMsgServerWithBank(t)
+	params := types.DefaultParams()
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, bank.MintCoins(ctx, "minter", sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100))))
+
+	// the max supply cannot be set below the supply
+	params.MaxSupply = math.NewInt(99)
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.ErrorIs(t, err, types.ErrMaxSupplyReached)
+
+	params.MaxSupply = math.NewInt(100)
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+
+	// inflation cannot be enabled again once the max supply was reached
+	k.SetMaxSupplyReached(ctx, true)
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.ErrorIs(t, err, types.ErrMaxSupplyReached)
+
+	params.EnableInflation = false
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+}
+
+func TestMsgUpdateParamsRemoveEmissionSchedule(t *testing.T) {
+	k, ms, ctx, _ := setupMsgServerWithBank(t)
+	params := types.DefaultParams()
+	params.EmissionSchedule = &types.EmissionSchedule{
+		InitialProvision: math.LegacyNewDec(100),
+		DecayFactor:      math.LegacyOneDec(),
+		FloorProvision:   math.LegacyZeroDec(),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(params.MintDenom, math.NewInt(100)),
+		sdk.NewDecCoin(params.MintDenom, math.NewInt(50)),
+	}))
+
+	// the emission schedule moved the period past the period mint provisions
+	k.SetPeriod(ctx, 5)
+	_, broken := keeper.PeriodRangeInvariant(k)(ctx)
+	require.False(t, broken)
+
+	params.EmissionSchedule = nil
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+
+	// the period is clamped to the end of the period mint provisions
+	require.Equal(t, uint64(2), k.GetPeriod(ctx))
+	msg, broken := keeper.PeriodRangeInvariant(k)(ctx)
+	require.False(t, broken, msg)
+	provision, err := k.GetEpochMintProvision(ctx)
+	require.NoError(t, err)
+	require.True(t, provision.IsZero())
+}
@@ -18,7 +18,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/golang/protobuf/proto"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
@@ -17,7 +17,306 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
 
 var _ types.QueryServer = Keeper{}
+
+// Period returns the current inflation period
+func (k Keeper) Period(
+	goCtx context.Context,
+	req *types.QueryPeriodRequest,
+) (*types.QueryPeriodResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPeriodResponse{Period: k.GetPeriod(ctx)}, nil
+}
+
+// EpochsPerPeriod returns the number of epochs of an inflation period
+func (k Keeper) EpochsPerPeriod(
+	goCtx context.Context,
+	req *types.QueryEpochsPerPeriodRequest,
+) (*types.QueryEpochsPerPeriodResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryEpochsPerPeriodResponse{EpochsPerPeriod: k.GetEpochsPerPeriod(ctx)}, nil
+}
+
+// SkippedEpochs returns the number of epochs without inflation
+func (k Keeper) SkippedEpochs(
+	goCtx context.Context,
+	req *types.QuerySkippedEpochsRequest,
+) (*types.QuerySkippedEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QuerySkippedEpochsResponse{SkippedEpochs: k.GetSkippedEpochs(ctx)}, nil
+}
+
+// EpochIdentifier returns the identifier of the epochs inflation is minted for
+func (k Keeper) EpochIdentifier(
+	goCtx context.Context,
+	req *types.QueryEpochIdentifierRequest,
+) (*types.QueryEpochIdentifierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryEpochIdentifierResponse{EpochIdentifier: k.GetEpochIdentifier(ctx)}, nil
+}
+
+// PeriodMintProvisions returns the mint provisions of all inflation periods
+func (k Keeper) PeriodMintProvisions(
+	goCtx context.Context,
+	req *types.QueryPeriodMintProvisionsRequest,
+) (*types.QueryPeriodMintProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provisions, err := k.GetPeriodMintProvisions(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPeriodMintProvisionsResponse{PeriodMintProvisions: provisions}, nil
+}
+
+// InflationDistribution returns the distribution of the minted coins
+func (k Keeper) InflationDistribution(
+	goCtx context.Context,
+	req *types.QueryInflationDistributionRequest,
+) (*types.QueryInflationDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryInflationDistributionResponse{InflationDistribution: k.GetInflationDistribution(ctx)}, nil
+}
+
+// EpochMintProvision returns the coins minted in an epoch of the current
+// period
+func (k Keeper) EpochMintProvision(
+	goCtx context.Context,
+	req *types.QueryEpochMintProvisionRequest,
+) (*types.QueryEpochMintProvisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	provision, err := k.GetEpochMintProvision(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEpochMintProvisionResponse{
+		EpochMintProvision: sdk.NewDecCoinFromDec(k.GetParams(ctx).MintDenom, provision),
+	}, nil
+}
+
+// InflationRate returns the annualized inflation rate of the current period
+func (k Keeper) InflationRate(
+	goCtx context.Context,
+	req *types.QueryInflationRateRequest,
+) (*types.QueryInflationRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rate, err := k.GetInflationRate(ctx, k.GetParams(ctx).MintDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInflationRateResponse{InflationRate: rate}, nil
+}
+
+// CirculatingSupply returns the supply of the mint denom in circulation
+func (k Keeper) CirculatingSupply(
+	goCtx context.Context,
+	req *types.QueryCirculatingSupplyRequest,
+) (*types.QueryCirculatingSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom := k.GetParams(ctx).MintDenom
+	return &types.QueryCirculatingSupplyResponse{
+		CirculatingSupply: sdk.NewDecCoinFromDec(denom, k.GetCirculatingSupply(ctx, denom)),
+	}, nil
+}
+
+// FailedEpochs returns the epochs whose inflation was not minted yet
+func (k Keeper) FailedEpochs(
+	goCtx context.Context,
+	req *types.QueryFailedEpochsRequest,
+) (*types.QueryFailedEpochsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var failedEpochs []types.FailedEpoch
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFailedEpoch)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var failed types.FailedEpoch
+		if err := k.cdc.Unmarshal(value, &failed); err != nil {
+			return err
+		}
+		failedEpochs = append(failedEpochs, failed)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFailedEpochsResponse{
+		FailedEpochs: failedEpochs,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// Remainder returns the remainder carried to the next epoch
+func (k Keeper) Remainder(
+	goCtx context.Context,
+	req *types.QueryRemainderRequest,
+) (*types.QueryRemainderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRemainderResponse{
+		Remainder:       k.GetRemainder(ctx),
+		RemainderPolicy: k.GetParams(ctx).RemainderPolicy,
+	}, nil
+}
+
+// EmissionPreview returns the mint provisions of the next periods
+func (k Keeper) EmissionPreview(
+	goCtx context.Context,
+	req *types.QueryEmissionPreviewRequest,
+) (*types.QueryEmissionPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	periods := req.Periods
+	if periods == 0 {
+		periods = types.DefaultPreviewPeriods
+	}
+	if periods > types.MaxPreviewPeriods {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d periods can be previewed", types.MaxPreviewPeriods)
+	}
+
+	provisions, err := k.PreviewEmission(ctx, periods)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryEmissionPreviewResponse{Provisions: provisions}, nil
+}
+
+// MaxSupply returns the max supply and the headroom left below it
+func (k Keeper) MaxSupply(
+	goCtx context.Context,
+	req *types.QueryMaxSupplyRequest,
+) (*types.QueryMaxSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	maxSupply := params.MaxSupply
+	if maxSupply.IsNil() {
+		maxSupply = math.ZeroInt()
+	}
+
+	headroom := math.ZeroInt()
+	if h, limited := k.getSupplyHeadroom(ctx, params); limited {
+		headroom = h.TruncateInt()
+	}
+
+	return &types.QueryMaxSupplyResponse{
+		MaxSupply: maxSupply,
+		Supply:    k.bankKeeper.GetSupply(ctx, params.MintDenom),
+		Headroom:  headroom,
+		Reached:   k.GetMaxSupplyReached(ctx),
+	}, nil
+}
+
+// DynamicInflation returns the multiplier of the epoch provision and the
+// bonded ratio it responds to
+func (k Keeper) DynamicInflation(
+	goCtx context.Context,
+	req *types.QueryDynamicInflationRequest,
+) (*types.QueryDynamicInflationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bondedRatio, err := k.GetBondedRatio(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+	return &types.QueryDynamicInflationResponse{
+		DynamicInflation: params.DynamicInflation,
+		Multiplier:       k.getMultiplier(ctx, params),
+		BondedRatio:      bondedRatio,
+	}, nil
+}
+
+// SimulateEmission returns the projected emission of the next epochs with the
+// proposed params, without changing the state
+func (k Keeper) SimulateEmission(
+	goCtx context.Context,
+	req *types.QuerySimulateEmissionRequest,
+) (*types.QuerySimulateEmissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Epochs > types.MaxSimulatedEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d epochs can be simulated", types.MaxSimulatedEpochs)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res, err := k.ProjectEmission(ctx, req.Params, req.PeriodMintProvisions, req.EpochsPerPeriod, req.Epochs, types.SimulateEmissionGasLimit)
+	if errors.Is(err, sdkerrors.ErrOutOfGas) {
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return res, nil
+}
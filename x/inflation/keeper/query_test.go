@@ -0,0 +1,413 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/Galactica-corp/galactica/testutil/keeper"
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestInflationStateQueries(t *testing.T) {
+	k, ctx := keepertest.InflationKeeper(t)
+	provisions := sdk.NewDecCoins(sdk.NewDecCoin("gnet", math.NewInt(365)))
+	distribution := types.InflationDistribution{
+		ValidatorsShare: math.LegacyNewDecWithPrec(9, 1),
+		OtherShares: []*types.InflationShare{
+			{Name: "dao", Address: sdk.AccAddress("dao").String(), Share: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	}
+	params := k.GetParams(ctx)
+	params.InflationDistribution = distribution
+	require.NoError(t, k.SetParams(ctx, params))
+
+	k.SetPeriod(ctx, 2)
+	k.SetEpochsPerPeriod(ctx, 365)
+	k.SetSkippedEpochs(ctx, 3)
+	k.SetEpochIdentifier(ctx, "day")
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, provisions))
+
+	period, err := k.Period(ctx, &types.QueryPeriodRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), period.Period)
+
+	epochsPerPeriod, err := k.EpochsPerPeriod(ctx, &types.QueryEpochsPerPeriodRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(365), epochsPerPeriod.EpochsPerPeriod)
+
+	skippedEpochs, err := k.SkippedEpochs(ctx, &types.QuerySkippedEpochsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), skippedEpochs.SkippedEpochs)
+
+	epochIdentifier, err := k.EpochIdentifier(ctx, &types.QueryEpochIdentifierRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "day", epochIdentifier.EpochIdentifier)
+
+	periodMintProvisions, err := k.PeriodMintProvisions(ctx, &types.QueryPeriodMintProvisionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, provisions, periodMintProvisions.PeriodMintProvisions)
+
+	inflationDistribution, err := k.InflationDistribution(ctx, &types.QueryInflationDistributionRequest{})
+	require.NoError(t, err)
+	require.True(t, distribution.Equal(&inflationDistribution.InflationDistribution))
+}
+
+func TestEpochMintProvisionQuery(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+	denom := types.DefaultParams().MintDenom
+
+	k.SetEpochsPerPeriod(ctx, 10)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(1000)),
+		sdk.NewDecCoin(denom, math.NewInt(500)),
+	}))
+	require.NoError(t, bank.MintCoins(ctx, "genesis", sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(10000)))))
+	require.NoError(t, bank.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(500)))))
+
+	testCases := []struct {
+		name         string
+		period       uint64
+		expProvision math.LegacyDec
+		expRate      math.LegacyDec
+	}{
+		{"first period", 0, math.LegacyNewDec(100), math.LegacyMustNewDecFromStr("9.523809523809523800")},
+		{"second period", 1, math.LegacyNewDec(50), math.LegacyMustNewDecFromStr("4.761904761904761900")},
+		{"all periods passed", 2, math.LegacyZeroDec(), math.LegacyZeroDec()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k.SetPeriod(ctx, tc.period)
+
+			provision, err := k.EpochMintProvision(ctx, &types.QueryEpochMintProvisionRequest{})
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewDecCoinFromDec(denom, tc.expProvision), provision.EpochMintProvision)
+
+			rate, err := k.InflationRate(ctx, &types.QueryInflationRateRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expRate, rate.InflationRate)
+		})
+	}
+
+	// the undistributed balance of the module is not in circulation
+	supply, err := k.CirculatingSupply(ctx, &types.QueryCirculatingSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin(denom, math.NewInt(10000)), supply.CirculatingSupply)
+	require.Equal(t, math.NewInt(500), bank.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), denom).Amount)
+}
+
+func TestFailedEpochsQuery(t *testing.T) {
+	k, ctx := keepertest.InflationKeeper(t)
+
+	var failedEpochs []types.FailedEpoch
+	for _, epochNumber := range []int64{300, 2, 1} {
+		failed := types.FailedEpoch{
+			EpochNumber:     epochNumber,
+			EpochIdentifier: "day",
+			Amount:          sdk.NewInt64Coin("gnet", epochNumber),
+			Reason:          "failed",
+		}
+		k.SetFailedEpoch(ctx, failed)
+		failedEpochs = append([]types.FailedEpoch{failed}, failedEpochs...)
+	}
+
+	res, err := k.FailedEpochs(ctx, &types.QueryFailedEpochsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, failedEpochs, res.FailedEpochs)
+
+	res, err = k.FailedEpochs(ctx, &types.QueryFailedEpochsRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, failedEpochs[:2], res.FailedEpochs)
+
+	res, err = k.FailedEpochs(ctx, &types.QueryFailedEpochsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, failedEpochs[2:], res.FailedEpochs)
+
+	_, err = k.FailedEpochs(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestRemainderQuery(t *testing.T) {
+	k, ctx := keepertest.InflationKeeper(t)
+
+	params := k.GetParams(ctx)
+	params.RemainderPolicy = types.RemainderPolicyCarry
+	require.NoError(t, k.SetParams(ctx, params))
+	remainder := sdk.NewCoins(sdk.NewInt64Coin("gnet", 3), sdk.NewInt64Coin("other", 1))
+	k.SetRemainder(ctx, remainder)
+
+	res, err := k.Remainder(ctx, &types.QueryRemainderRequest{})
+	require.NoError(t, err)
+	require.Equal(t, remainder, res.Remainder)
+	require.Equal(t, types.RemainderPolicyCarry, res.RemainderPolicy)
+
+	// setting the remainder replaces all denoms
+	k.SetRemainder(ctx, sdk.NewCoins(sdk.NewInt64Coin("other", 2)))
+	res, err = k.Remainder(ctx, &types.QueryRemainderRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("other", 2)), res.Remainder)
+}
+
+func TestMaxSupplyQuery(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+	denom := k.GetParams(ctx).MintDenom
+	require.NoError(t, bank.MintCoins(ctx, "minter", sdk.NewCoins(sdk.NewInt64Coin(denom, 40))))
+
+	// no max supply
+	res, err := k.MaxSupply(ctx, &types.QueryMaxSupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, res.MaxSupply.IsZero())
+	require.True(t, res.Headroom.IsZero())
+	require.Equal(t, sdk.NewInt64Coin(denom, 40), res.Supply)
+	require.False(t, res.Reached)
+
+	params := k.GetParams(ctx)
+	params.MaxSupply = math.NewInt(100)
+	require.NoError(t, k.SetParams(ctx, params))
+	k.SetMaxSupplyReached(ctx, true)
+
+	res, err = k.MaxSupply(ctx, &types.QueryMaxSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), res.MaxSupply)
+	require.Equal(t, math.NewInt(60), res.Headroom)
+	require.True(t, res.Reached)
+
+	_, err = k.MaxSupply(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestDynamicInflationQuery(t *testing.T) {
+	staking := keepertest.NewStakingKeeper(60, 100)
+	k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), nil, nil, nil, staking)
+	k.SetMultiplier(ctx, math.LegacyNewDec(3))
+
+	// the multiplier is not applied without dynamic inflation
+	res, err := k.DynamicInflation(ctx, &types.QueryDynamicInflationRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.DynamicInflation)
+	require.Equal(t, math.LegacyOneDec(), res.Multiplier)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.6"), res.BondedRatio)
+
+	// the multiplier is kept within the bounds
+	params := k.GetParams(ctx)
+	params.DynamicInflation = &types.DynamicInflation{
+		TargetBondedRatio:  math.LegacyMustNewDecFromStr("0.67"),
+		MinMultiplier:      math.LegacyMustNewDecFromStr("0.5"),
+		MaxMultiplier:      math.LegacyNewDec(2),
+		MaxChangePerPeriod: math.LegacyMustNewDecFromStr("0.2"),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	res, err = k.DynamicInflation(ctx, &types.QueryDynamicInflationRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params.DynamicInflation, res.DynamicInflation)
+	require.Equal(t, math.LegacyNewDec(2), res.Multiplier)
+
+	_, err = k.DynamicInflation(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestEmissionPreviewQuery(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithBank(t, bank)
+	denom := types.DefaultParams().MintDenom
+
+	k.SetPeriod(ctx, 1)
+	k.SetEpochsPerPeriod(ctx, 10)
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(1000)),
+		sdk.NewDecCoin(denom, math.NewInt(500)),
+	}))
+
+	previewed := func(periods uint32) []int64 {
+		res, err := k.EmissionPreview(ctx, &types.QueryEmissionPreviewRequest{Periods: periods})
+		require.NoError(t, err)
+
+		var provisions []int64
+		for i, provision := range res.Provisions {
+			require.Equal(t, k.GetPeriod(ctx)+uint64(i), provision.Period)
+			require.Equal(t, provision.PeriodProvision.Amount.QuoInt64(10), provision.EpochProvision.Amount)
+			provisions = append(provisions, provision.PeriodProvision.Amount.TruncateInt64())
+		}
+		return provisions
+	}
+
+	// the period mint provisions end after their last period
+	require.Equal(t, []int64{500, 0, 0}, previewed(3))
+	require.Len(t, previewed(0), int(types.DefaultPreviewPeriods))
+
+	params := k.GetParams(ctx)
+	params.EmissionSchedule = &types.EmissionSchedule{
+		InitialProvision: math.LegacyNewDec(1000),
+		DecayFactor:      math.LegacyMustNewDecFromStr("0.5"),
+		FloorProvision:   math.LegacyNewDec(200),
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, []int64{500, 250, 200, 200}, previewed(4))
+
+	// the provisions are limited by the max supply
+	require.NoError(t, bank.MintCoins(ctx, "other", sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	params.MaxSupply = math.NewInt(2000)
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, []int64{500, 250, 200, 50, 0}, previewed(5))
+
+	epochMintProvision, err := k.EpochMintProvision(ctx, &types.QueryEpochMintProvisionRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(50), epochMintProvision.EpochMintProvision.Amount)
+
+	_, err = k.EmissionPreview(ctx, &types.QueryEmissionPreviewRequest{Periods: types.MaxPreviewPeriods + 1})
+	require.Error(t, err)
+}
+
+func TestSimulateEmissionQuery(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, keepertest.NewDistrKeeper(bank), nil, nil)
+
+	account := sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.InflationDistribution = types.InflationDistribution{
+		ValidatorsShare: math.LegacyMustNewDecFromStr("0.5"),
+		OtherShares: []*types.InflationShare{
+			{Address: account, Share: math.LegacyMustNewDecFromStr("0.3")},
+			{Kind: types.RecipientKindBurn, Share: math.LegacyMustNewDecFromStr("0.15")},
+		},
+	}
+	params.RemainderPolicy = types.RemainderPolicyCarry
+	params.MaxSupply = math.NewInt(450)
+	denom := params.MintDenom
+	k.SetEpochIdentifier(ctx, "day")
+	k.SetEpochsPerPeriod(ctx, 1)
+
+	// the current state is replaced by the proposed params
+	res, err := k.SimulateEmission(ctx, &types.QuerySimulateEmissionRequest{
+		Params: &params,
+		PeriodMintProvisions: sdk.DecCoins{
+			sdk.NewDecCoin(denom, math.NewInt(300)),
+			sdk.NewDecCoin(denom, math.NewInt(300)),
+		},
+		EpochsPerPeriod: 3,
+		Epochs:          8,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Epochs, 8)
+	require.Equal(t, sdk.NewInt64Coin(denom, 100), res.Epochs[0].Minted)
+	require.Equal(t, []types.EmissionAllocation{
+		{Recipient: types.RecipientValidators, Amount: sdk.NewInt64Coin(denom, 50)},
+		{Recipient: account, Amount: sdk.NewInt64Coin(denom, 30)},
+		{Recipient: types.RecipientBurn, Amount: sdk.NewInt64Coin(denom, 15)},
+	}, res.Epochs[0].Allocations)
+	// the period advances after the epoch that passes it, so the first period
+	// has one epoch more
+	require.Len(t, res.Periods, 3)
+	require.Equal(t, sdk.NewInt64Coin(denom, 400), res.Periods[0].Minted)
+	require.True(t, res.Periods[1].Minted.IsLT(res.Periods[0].Minted))
+
+	// the mint is clamped to the headroom below the max supply, which the
+	// burned coins keep open, and nothing is minted after the schedule ended
+	require.Equal(t, sdk.NewInt64Coin(denom, 423), res.Epochs[4].Supply)
+	require.Equal(t, sdk.NewInt64Coin(denom, 27), res.Epochs[5].Minted)
+	require.True(t, res.Periods[2].Minted.IsZero())
+
+	// the remainder is allocated by the remainder policy
+	communityPool := params
+	communityPool.RemainderPolicy = types.RemainderPolicyCommunityPool
+	cpRes, err := k.SimulateEmission(ctx, &types.QuerySimulateEmissionRequest{
+		Params:               &communityPool,
+		PeriodMintProvisions: sdk.DecCoins{sdk.NewDecCoin(denom, math.NewInt(300))},
+		EpochsPerPeriod:      3,
+		Epochs:               1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.EmissionAllocation{
+		Recipient: types.RecipientCommunityPool,
+		Amount:    sdk.NewInt64Coin(denom, 5),
+	}, cpRes.Epochs[0].Allocations[3])
+
+	// nothing was committed
+	require.Equal(t, int64(1), k.GetEpochsPerPeriod(ctx))
+	require.Zero(t, k.GetPeriod(ctx))
+	require.True(t, bank.GetSupply(ctx, denom).IsZero())
+	require.True(t, k.GetRemainder(ctx).IsZero())
+
+	// the projection matches the epochs as they are minted
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.SetPeriodMintProvisions(ctx, sdk.DecCoins{
+		sdk.NewDecCoin(denom, math.NewInt(300)),
+		sdk.NewDecCoin(denom, math.NewInt(300)),
+	}))
+	k.SetEpochsPerPeriod(ctx, 3)
+	for _, epoch := range res.Epochs {
+		require.Equal(t, epoch.Period, k.GetPeriod(ctx), "epoch %d", epoch.EpochNumber)
+		k.BeforeEpochStart(ctx, "day", epoch.EpochNumber)
+		require.Equal(t, epoch.Supply, bank.GetSupply(ctx, denom), "epoch %d", epoch.EpochNumber)
+	}
+	require.Equal(t, res.Supply, bank.GetSupply(ctx, denom))
+
+	received := map[string]sdk.Coin{
+		types.RecipientValidators: bank.GetBalance(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), denom),
+		account:                   bank.GetBalance(ctx, sdk.MustAccAddressFromBech32(account), denom),
+	}
+	for _, allocation := range res.Allocations {
+		if coin, ok := received[allocation.Recipient]; ok {
+			require.Equal(t, coin, allocation.Amount, allocation.Recipient)
+		}
+	}
+
+	// without overrides the current state is simulated
+	res, err = k.SimulateEmission(ctx, &types.QuerySimulateEmissionRequest{Epochs: 2})
+	require.NoError(t, err)
+	require.Len(t, res.Epochs, 2)
+	require.True(t, res.Epochs[0].Minted.IsZero())
+	require.Equal(t, bank.GetSupply(ctx, denom), res.Supply)
+
+	invalid := params
+	invalid.MintDenom = ""
+	_, err = k.SimulateEmission(ctx, &types.QuerySimulateEmissionRequest{Params: &invalid})
+	require.Error(t, err)
+	_, err = k.SimulateEmission(ctx, &types.QuerySimulateEmissionRequest{Epochs: types.MaxSimulatedEpochs + 1})
+	require.Error(t, err)
+	_, err = k.SimulateEmission(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestProjectEmissionOutOfGas(t *testing.T) {
+	bank := keepertest.NewBankKeeper()
+	k, ctx := keepertest.InflationKeeperWithKeepers(t, keepertest.NewInflationAccountKeeper(), bank, keepertest.NewDistrKeeper(bank), nil, nil)
+	k.SetEpochIdentifier(ctx, "day")
+	denom := k.GetParams(ctx).MintDenom
+	supply := bank.GetSupply(ctx, denom)
+
+	res, err := k.ProjectEmission(ctx, nil, nil, 0, 10, types.SimulateEmissionGasLimit)
+	require.NoError(t, err)
+	require.Len(t, res.Epochs, 10)
+
+	// the simulation stops once the gas limit is consumed, which is metered
+	// apart from the query
+	gasConsumed := ctx.GasMeter().GasConsumed()
+	_, err = k.ProjectEmission(ctx, nil, nil, 0, types.MaxSimulatedEpochs, 10_000)
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.Equal(t, gasConsumed, ctx.GasMeter().GasConsumed())
+	require.Equal(t, supply, bank.GetSupply(ctx, denom))
+}
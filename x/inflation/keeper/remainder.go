@@ -0,0 +1,140 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// GetRemainder returns the remainder carried to the next epoch, which the
+// inflation module account holds
+func (k Keeper) GetRemainder(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRemainder)
+
+	iterator := storetypes.KVStorePrefixIterator(store, nil)
+	defer iterator.Close()
+
+	remainder := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		remainder = remainder.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return remainder
+}
+
+// SetRemainder stores the remainder carried to the next epoch
+func (k Keeper) SetRemainder(ctx sdk.Context, remainder sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRemainder)
+	for _, coin := range k.GetRemainder(ctx) {
+		store.Delete([]byte(coin.Denom))
+	}
+
+	for _, coin := range remainder {
+		if !coin.IsPositive() {
+			continue
+		}
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set([]byte(coin.Denom), bz)
+	}
+}
+
+// settleRemainder applies the remainder policy to the coin of an epoch that
+// no share received. The remainder of the same denom carried from previous
+// epochs must be part of the coin.
+func (k Keeper) settleRemainder(ctx sdk.Context, remainder sdk.Coin, policy types.RemainderPolicy) error {
+	carried := sdk.NewCoins()
+	for _, coin := range k.GetRemainder(ctx) {
+		if coin.Denom != remainder.Denom {
+			carried = carried.Add(coin)
+		}
+	}
+
+	if remainder.IsPositive() {
+		coins := sdk.NewCoins(remainder)
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+
+		var (
+			recipient string
+			err       error
+		)
+		switch policy {
+		case types.RemainderPolicyCarry:
+			carried = carried.Add(remainder)
+		case types.RemainderPolicyCommunityPool:
+			recipient = types.RecipientCommunityPool
+			err = k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr)
+		case types.RemainderPolicyBurn:
+			recipient = types.RecipientBurn
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+		default:
+			recipient = types.RecipientValidators
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
+		}
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to settle remainder %s", remainder)
+		}
+		if recipient != "" {
+			k.emitAllocation(ctx, recipient, remainder)
+		}
+	}
+
+	k.SetRemainder(ctx, carried)
+	return nil
+}
+
+// SweepStrandedBalance sends everything the inflation module account holds
+// besides the carried remainder to the given recipient, e.g. coins of a
+// failed epoch or coins sent to the module account
+func (k Keeper) SweepStrandedBalance(ctx sdk.Context, recipient string) (sdk.Coins, error) {
+	share := types.NewInflationShare(recipient, "", math.LegacyOneDec())
+	if err := share.ValidateRecipient(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDistribution, err.Error())
+	}
+
+	// the carried remainder is allocated in the next epoch and not stranded
+	balance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	balance, negative := balance.SafeSub(k.GetRemainder(ctx)...)
+	if negative || balance.IsZero() {
+		return nil, types.ErrNoStrandedBalance
+	}
+
+	if err := k.SendShare(ctx, share, balance); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRedirectStrandedBalance{
+		Recipient: recipient,
+		Amount:    balance,
+	}); err != nil {
+		return nil, err
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,188 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// ProjectEmission projects the emission of the given number of epochs after
+// the current epoch, one period if zero, but at most MaxSimulatedEpochs. The
+// params, period mint provisions and epochs per period replace the current
+// ones if set. The epochs are minted and allocated by the epoch hook on a
+// branch of the state that is discarded, so that nothing is committed, and
+// the projection is read from the emitted events and the supply. The branch
+// is metered with the gas limit and an error is returned when the simulation
+// runs out of gas.
+func (k Keeper) ProjectEmission(
+	ctx sdk.Context,
+	params *types.Params,
+	periodMintProvisions sdk.DecCoins,
+	epochsPerPeriod int64,
+	epochs uint32,
+	gasLimit uint64,
+) (res *types.QuerySimulateEmissionResponse, err error) {
+	branch, _ := ctx.CacheContext()
+	branch = branch.
+		WithLogger(log.NewNopLogger()).
+		WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "emission simulation out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	if params != nil {
+		if err := params.Validate(); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid params")
+		}
+		if err := k.ValidateRecipients(params.InflationDistribution); err != nil {
+			return nil, err
+		}
+		if err := k.SetParams(branch, *params); err != nil {
+			return nil, err
+		}
+	}
+	if len(periodMintProvisions) > 0 {
+		for i, provision := range periodMintProvisions {
+			if err := provision.Validate(); err != nil {
+				return nil, errorsmod.Wrapf(err, "invalid mint provision of period %d", i)
+			}
+		}
+		if err := k.SetPeriodMintProvisions(branch, periodMintProvisions); err != nil {
+			return nil, err
+		}
+	}
+	if epochsPerPeriod > 0 {
+		k.SetEpochsPerPeriod(branch, epochsPerPeriod)
+	}
+	if epochs == 0 {
+		epochs = uint32(min(k.GetEpochsPerPeriod(branch), int64(types.MaxSimulatedEpochs)))
+	}
+
+	denom := k.GetParams(branch).MintDenom
+	identifier := k.GetEpochIdentifier(branch)
+
+	var epochNumber int64
+	if k.epochsKeeper != nil {
+		if info, found := k.epochsKeeper.GetEpochInfo(branch, identifier); found {
+			epochNumber = info.CurrentEpoch
+		}
+	}
+
+	res = &types.QuerySimulateEmissionResponse{}
+	total := allocations{}
+	for i := uint32(0); i < epochs; i++ {
+		epochNumber++
+		period := k.GetPeriod(branch)
+
+		epochCtx := branch.WithEventManager(sdk.NewEventManager())
+		k.BeforeEpochStart(epochCtx, identifier, epochNumber)
+
+		minted, epochAllocations, err := readEmission(epochCtx.EventManager().Events())
+		if err != nil {
+			return nil, err
+		}
+
+		if n := len(res.Periods); n == 0 || res.Periods[n-1].Period != period {
+			res.Periods = append(res.Periods, types.SimulatedPeriod{
+				Period: period,
+				Minted: sdk.NewCoin(denom, math.ZeroInt()),
+			})
+		}
+		res.Periods[len(res.Periods)-1].Minted = res.Periods[len(res.Periods)-1].Minted.AddAmount(minted)
+
+		for _, allocation := range epochAllocations {
+			total.add(allocation.Recipient, allocation.Amount)
+		}
+		res.Epochs = append(res.Epochs, types.SimulatedEpoch{
+			EpochNumber: epochNumber,
+			Period:      period,
+			Minted:      sdk.NewCoin(denom, minted),
+			Allocations: epochAllocations,
+			Supply:      k.bankKeeper.GetSupply(branch, denom),
+		})
+	}
+
+	res.Allocations = total
+	res.Supply = k.bankKeeper.GetSupply(branch, denom)
+	return res, nil
+}
+
+// readEmission returns the amount minted in an epoch and the allocations of
+// the minted coins and the carried remainder from the events of the epoch
+func readEmission(events sdk.Events) (math.Int, allocations, error) {
+	minted := math.ZeroInt()
+	allocated := allocations{}
+
+	for _, event := range events {
+		switch event.Type {
+		case types.EventTypeMint:
+			for _, attr := range event.Attributes {
+				if attr.Key != sdk.AttributeKeyAmount {
+					continue
+				}
+				amount, ok := math.NewIntFromString(attr.Value)
+				if !ok {
+					return math.Int{}, nil, fmt.Errorf("invalid minted amount: %s", attr.Value)
+				}
+				minted = minted.Add(amount)
+			}
+		case proto.MessageName(&types.EventAllocateInflation{}):
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				return math.Int{}, nil, err
+			}
+			if allocation, ok := msg.(*types.EventAllocateInflation); ok {
+				allocated.add(allocation.Recipient, allocation.Amount)
+			}
+		}
+	}
+
+	return minted, allocated, nil
+}
+
+// allocations are the amounts of recipients in the order they first receive
+// coins
+type allocations []types.EmissionAllocation
+
+// add adds the coin to the amount of the recipient
+func (a *allocations) add(recipient string, coin sdk.Coin) {
+	for i, allocation := range *a {
+		if allocation.Recipient == recipient && allocation.Amount.Denom == coin.Denom {
+			(*a)[i].Amount = allocation.Amount.Add(coin)
+			return
+		}
+	}
+	*a = append(*a, types.EmissionAllocation{Recipient: recipient, Amount: coin})
+}
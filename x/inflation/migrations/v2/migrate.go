@@ -0,0 +1,68 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// InflationDistributionKey is the v1 store key of the inflation distribution
+// that was kept next to the distribution of the params
+var InflationDistributionKey = []byte("inflation_distribution_inflation")
+
+// MigrateStore performs in-place store migrations from v1 to v2. The v2 store
+// keeps the inflation distribution only in the params:
+//
+// - the v1 distribution under InflationDistributionKey, which was the one used
+// for minting, replaces the distribution of the params
+// - the v1 distribution key is deleted
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if bz := store.Get(InflationDistributionKey); bz != nil {
+		var distribution types.InflationDistribution
+		if err := cdc.Unmarshal(bz, &distribution); err != nil {
+			return err
+		}
+		params.InflationDistribution = distribution
+		store.Delete(InflationDistributionKey)
+	}
+
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
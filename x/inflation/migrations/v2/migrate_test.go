@@ -0,0 +1,68 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/testutil/sample"
+	v2 "github.com/Galactica-corp/galactica/x/inflation/migrations/v2"
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	// the distribution used for minting differs from the params
+	distribution := types.InflationDistribution{
+		ValidatorsShare: math.LegacyMustNewDecFromStr("0.99933"),
+		OtherShares: []*types.InflationShare{
+			{Name: "faucet", Address: sample.AccAddress(), Share: math.LegacyMustNewDecFromStr("0.00067")},
+		},
+	}
+	store.Set(v2.InflationDistributionKey, cdc.MustMarshal(&distribution))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+	require.False(t, store.Has(v2.InflationDistributionKey))
+
+	var migrated types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &migrated)
+	require.Equal(t, params.MintDenom, migrated.MintDenom)
+	require.Equal(t, params.EnableInflation, migrated.EnableInflation)
+	require.True(t, distribution.Equal(&migrated.InflationDistribution))
+
+	// without a v1 distribution the params are kept
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &migrated)
+	require.True(t, distribution.Equal(&migrated.InflationDistribution))
+
+	// an invalid distribution aborts the migration
+	invalid := types.InflationDistribution{ValidatorsShare: math.LegacyNewDec(2)}
+	store.Set(v2.InflationDistributionKey, cdc.MustMarshal(&invalid))
+	require.Error(t, v2.MigrateStore(ctx, storeKey, cdc))
+}
@@ -26,7 +26,6 @@ import (
 
 	"github.com/Galactica-corp/galactica/testutil/sample"
 	inflationsimulation "github.com/Galactica-corp/galactica/x/inflation/simulation"
-	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
 
 // avoid unused import issue
@@ -44,15 +43,8 @@ const (
 
 // GenerateGenesisState creates a randomized GenState of the module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	accs := make([]string, len(simState.Accounts))
-	for i, acc := range simState.Accounts {
-		accs[i] = acc.Address.String()
-	}
-	inflationGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
-		// this line is used by starport scaffolding # simapp/module/genesisState
-	}
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&inflationGenesis)
+	inflationsimulation.RandomizedGenState(simState)
+	// this line is used by starport scaffolding # simapp/module/genesisState
 }
 
 // RegisterStoreDecoder registers a decoder.
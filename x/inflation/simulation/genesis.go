@@ -0,0 +1,208 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+// Simulation parameter constants
+const (
+	EpochsPerPeriod       = "epochs_per_period"
+	InflationDistribution = "inflation_distribution"
+	RemainderPolicy       = "remainder_policy"
+	EmissionSchedule      = "emission_schedule"
+	MaxSupply             = "max_supply"
+	DynamicInflation      = "dynamic_inflation"
+)
+
+// GenEpochsPerPeriod randomized EpochsPerPeriod
+func GenEpochsPerPeriod(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 1, 400))
+}
+
+// GenInflationDistribution randomized InflationDistribution. Up to three
+// simulation accounts, the community pool and the burn receive a share,
+// validators receive the rest.
+func GenInflationDistribution(r *rand.Rand, accs []simtypes.Account) types.InflationDistribution {
+	distribution := types.DefaultInflationDistribution()
+
+	n := r.Intn(4)
+	if n > len(accs) {
+		n = len(accs)
+	}
+	recipients := make([]string, 0, n+2)
+	for _, i := range r.Perm(len(accs))[:n] {
+		recipients = append(recipients, accs[i].Address.String())
+	}
+	if r.Intn(2) == 0 {
+		recipients = append(recipients, types.RecipientCommunityPool)
+	}
+	if r.Intn(2) == 0 {
+		recipients = append(recipients, types.RecipientBurn)
+	}
+
+	remaining := math.LegacyOneDec()
+	for _, recipient := range recipients {
+		// each share takes at most a third of the remaining inflation
+		share := remaining.QuoInt64(3).MulInt64(int64(r.Intn(100))).QuoInt64(100)
+		remaining = remaining.Sub(share)
+		s := types.NewInflationShare(recipient, "", share)
+		distribution.OtherShares = append(distribution.OtherShares, &s)
+	}
+	distribution.ValidatorsShare = remaining
+
+	return distribution
+}
+
+// GenRemainderPolicy randomized RemainderPolicy
+func GenRemainderPolicy(r *rand.Rand) types.RemainderPolicy {
+	return types.RemainderPolicy(r.Intn(len(types.RemainderPolicy_name)))
+}
+
+// GenEmissionSchedule randomized EmissionSchedule. Half of the time no
+// schedule is set and the period mint provisions of the genesis are used.
+func GenEmissionSchedule(r *rand.Rand) *types.EmissionSchedule {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+
+	initial := math.LegacyNewDec(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000)))
+	return &types.EmissionSchedule{
+		InitialProvision: initial,
+		DecayFactor:      math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2),
+		FloorProvision:   initial.MulInt64(int64(r.Intn(50))).QuoInt64(100),
+	}
+}
+
+// GenMaxSupply randomized MaxSupply above the given supply. Half of the time
+// no max supply is set.
+func GenMaxSupply(r *rand.Rand, supply math.Int) math.Int {
+	if r.Intn(2) == 0 {
+		return math.ZeroInt()
+	}
+	return supply.AddRaw(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000)))
+}
+
+// GenDynamicInflation randomized DynamicInflation. Half of the time dynamic
+// inflation is disabled.
+func GenDynamicInflation(r *rand.Rand) *types.DynamicInflation {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+
+	minMultiplier := math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2)
+	return &types.DynamicInflation{
+		TargetBondedRatio:  math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2),
+		MinMultiplier:      minMultiplier,
+		MaxMultiplier:      minMultiplier.Add(math.LegacyNewDecWithPrec(int64(r.Intn(301)), 2)),
+		MaxChangePerPeriod: math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2),
+	}
+}
+
+// RandomizedGenState generates a random GenesisState for inflation
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		epochsPerPeriod       int64
+		inflationDistribution types.InflationDistribution
+		remainderPolicy       types.RemainderPolicy
+		emissionSchedule      *types.EmissionSchedule
+		maxSupply             math.Int
+		dynamicInflation      *types.DynamicInflation
+	)
+
+	simState.AppParams.GetOrGenerate(EpochsPerPeriod, &epochsPerPeriod, simState.Rand, func(r *rand.Rand) {
+		epochsPerPeriod = GenEpochsPerPeriod(r)
+	})
+	simState.AppParams.GetOrGenerate(InflationDistribution, &inflationDistribution, simState.Rand, func(r *rand.Rand) {
+		inflationDistribution = GenInflationDistribution(r, simState.Accounts)
+	})
+	simState.AppParams.GetOrGenerate(RemainderPolicy, &remainderPolicy, simState.Rand, func(r *rand.Rand) {
+		remainderPolicy = GenRemainderPolicy(r)
+	})
+	simState.AppParams.GetOrGenerate(EmissionSchedule, &emissionSchedule, simState.Rand, func(r *rand.Rand) {
+		emissionSchedule = GenEmissionSchedule(r)
+	})
+	simState.AppParams.GetOrGenerate(DynamicInflation, &dynamicInflation, simState.Rand, func(r *rand.Rand) {
+		dynamicInflation = GenDynamicInflation(r)
+	})
+
+	inflationGenesis := types.DefaultGenesis()
+	simState.AppParams.GetOrGenerate(MaxSupply, &maxSupply, simState.Rand, func(r *rand.Rand) {
+		maxSupply = GenMaxSupply(r, genesisSupply(simState, inflationGenesis.Params.MintDenom))
+	})
+
+	inflationGenesis.EpochsPerPeriod = epochsPerPeriod
+	inflationGenesis.Params.InflationDistribution = inflationDistribution
+	inflationGenesis.Params.RemainderPolicy = remainderPolicy
+	inflationGenesis.Params.EmissionSchedule = emissionSchedule
+	inflationGenesis.Params.MaxSupply = maxSupply
+	inflationGenesis.Params.DynamicInflation = dynamicInflation
+
+	bz, err := json.MarshalIndent(inflationGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated inflation parameters:\n%s\n", bz)
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(inflationGenesis)
+
+	registerMintDenomMetadata(simState, inflationGenesis.Params.MintDenom)
+}
+
+// genesisSupply returns the supply of the denom in the bank genesis
+func genesisSupply(simState *module.SimulationState, denom string) math.Int {
+	var bankGenesis banktypes.GenesisState
+	if bz, ok := simState.GenState[banktypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, &bankGenesis)
+	}
+	return bankGenesis.Supply.AmountOf(denom)
+}
+
+// registerMintDenomMetadata adds the bank denom metadata that the inflation
+// genesis requires for the mint denom
+func registerMintDenomMetadata(simState *module.SimulationState, denom string) {
+	var bankGenesis banktypes.GenesisState
+	if bz, ok := simState.GenState[banktypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, &bankGenesis)
+	}
+
+	for _, metadata := range bankGenesis.DenomMetadata {
+		if metadata.Base == denom {
+			return
+		}
+	}
+
+	bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, banktypes.Metadata{
+		Description: "The native token of the simulation",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      denom,
+	})
+	simState.GenState[banktypes.ModuleName] = simState.Cdc.MustMarshalJSON(&bankGenesis)
+}
@@ -31,6 +31,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 
 	cdc.RegisterConcrete(Params{}, "galactica/x/inflation/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "galactica/x/inflation/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgAddInflationShare{}, "galactica/x/inflation/MsgAddShare")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateInflationShare{}, "galactica/x/inflation/MsgUpdateShare")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveInflationShare{}, "galactica/x/inflation/MsgRemoveShare")
+	legacy.RegisterAminoMsg(cdc, &MsgSetValidatorsShare{}, "galactica/x/inflation/MsgSetValShare")
+	legacy.RegisterAminoMsg(cdc, &MsgRetryFailedEpoch{}, "galactica/x/inflation/MsgRetryEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgRedirectStrandedBalance{}, "galactica/x/inflation/MsgRedirect")
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -38,6 +44,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgAddInflationShare{},
+		&MsgUpdateInflationShare{},
+		&MsgRemoveInflationShare{},
+		&MsgSetValidatorsShare{},
+		&MsgRetryFailedEpoch{},
+		&MsgRedirectStrandedBalance{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -26,4 +26,13 @@ import (
 var (
 	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+
+	ErrInvalidMintDenom       = sdkerrors.Register(ModuleName, 1102, "invalid mint denom")
+	ErrInvalidEpochIdentifier = sdkerrors.Register(ModuleName, 1103, "invalid epoch identifier")
+	ErrInvalidDistribution    = sdkerrors.Register(ModuleName, 1104, "invalid inflation distribution")
+	ErrShareNotFound          = sdkerrors.Register(ModuleName, 1105, "inflation share not found")
+	ErrShareExists            = sdkerrors.Register(ModuleName, 1106, "inflation share already exists")
+	ErrFailedEpochNotFound    = sdkerrors.Register(ModuleName, 1107, "failed epoch not found")
+	ErrNoStrandedBalance      = sdkerrors.Register(ModuleName, 1108, "no stranded balance")
+	ErrMaxSupplyReached       = sdkerrors.Register(ModuleName, 1109, "max supply reached")
 )
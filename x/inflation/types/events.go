@@ -21,4 +21,7 @@ const (
 
 	AttributeKeyEpochProvisions = "epoch_provisions"
 	AttributeEpochNumber        = "epoch_number"
+	AttributeKeyRemainder       = "remainder"
+	AttributeKeyCarried         = "carried_remainder"
+	AttributeKeyMultiplier      = "multiplier"
 )
@@ -24,6 +24,9 @@ import (
 	"cosmossdk.io/x/nft"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	epochstypes "github.com/Galactica-corp/galactica/x/epochs/types"
 )
 
 type DistrKeeper interface {
@@ -34,6 +37,7 @@ type DistrKeeper interface {
 // AccountKeeper defines the expected interface for the Account module.
 type AccountKeeper interface {
 	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
 	// Methods imported from account should be defined here
 }
 
@@ -48,14 +52,20 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	HasSupply(ctx context.Context, denom string) bool
 	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// EpochsKeeper defines the expected interface for the Epochs module.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
 }
 
 // StakingKeeper defines the expected interface for the Staking module.
 type StakingKeeper interface {
-	TotalBondedTokens(context.Context) math.Int
+	TotalBondedTokens(context.Context) (math.Int, error)
 	// BondedRatio the fraction of the staking tokens which are currently bonded
-	BondedRatio(ctx context.Context) math.LegacyDec
-	StakingTokenSupply(ctx context.Context) math.Int
+	BondedRatio(ctx context.Context) (math.LegacyDec, error)
+	StakingTokenSupply(ctx context.Context) (math.Int, error)
 }
 
 // SlashingKeeper defines the expected interface for the Slashing module.
@@ -17,6 +17,8 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -31,11 +33,12 @@ const (
 	defaultDenom = "gnet"
 )
 
+// DefaultInflationDistribution returns the default distribution of the minted
+// coins
 func DefaultInflationDistribution() InflationDistribution {
 	return InflationDistribution{
 		// If no other shares are specified, validators get 100% of the inflation
 		ValidatorsShare: math.LegacyMustNewDecFromStr("1.0"),
-		OtherShares:     []*InflationShare{},
 	}
 }
 
@@ -73,8 +76,8 @@ func DefaultGenesis() *GenesisState {
 		EpochsPerPeriod: 365,
 		SkippedEpochs:   0,
 
-		PeriodMintProvisions:  DefaultPeriodMintProvisions(),
-		InflationDistribution: DefaultInflationDistribution(),
+		PeriodMintProvisions: DefaultPeriodMintProvisions(),
+		Multiplier:           math.LegacyOneDec(),
 	}
 }
 
@@ -82,6 +85,40 @@ func DefaultGenesis() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := epochstypes.ValidateEpochIdentifierString(gs.EpochIdentifier); err != nil {
+		return err
+	}
+	if gs.EpochsPerPeriod <= 0 {
+		return fmt.Errorf("epochs per period must be positive: %d", gs.EpochsPerPeriod)
+	}
+	for i, provision := range gs.PeriodMintProvisions {
+		if err := provision.Validate(); err != nil {
+			return fmt.Errorf("invalid mint provision of period %d: %w", i, err)
+		}
+	}
 
-	return gs.Params.Validate()
+	epochNumbers := make(map[int64]bool, len(gs.FailedEpochs))
+	for _, failed := range gs.FailedEpochs {
+		if err := failed.Validate(); err != nil {
+			return err
+		}
+		if epochNumbers[failed.EpochNumber] {
+			return fmt.Errorf("duplicated failed epoch %d", failed.EpochNumber)
+		}
+		epochNumbers[failed.EpochNumber] = true
+	}
+
+	if err := gs.Remainder.Validate(); err != nil {
+		return fmt.Errorf("invalid remainder: %w", err)
+	}
+	if gs.MaxSupplyReached && gs.Params.EnableInflation {
+		return fmt.Errorf("inflation cannot be enabled once the max supply was reached")
+	}
+	if !gs.Multiplier.IsNil() && gs.Multiplier.IsNegative() {
+		return fmt.Errorf("multiplier must not be negative: %s", gs.Multiplier)
+	}
+	return nil
 }
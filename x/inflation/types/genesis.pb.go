@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -39,8 +41,16 @@ type GenesisState struct {
 	// skipped_epochs is the number of epochs that have passed while inflation is disabled
 	SkippedEpochs        uint64                                      `protobuf:"varint,5,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
 	PeriodMintProvisions github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=period_mint_provisions,json=periodMintProvisions,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"period_mint_provisions"`
-	// inflation_distribution defines the initial distribution of inflation
-	InflationDistribution InflationDistribution `protobuf:"bytes,7,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
+	// failed_epochs are the epochs whose inflation was not minted yet
+	FailedEpochs []FailedEpoch `protobuf:"bytes,8,rep,name=failed_epochs,json=failedEpochs,proto3" json:"failed_epochs"`
+	// remainder carried to the next epoch, held by the inflation module account
+	Remainder github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remainder"`
+	// max_supply_reached is set once the supply reached the max supply, after
+	// which inflation stays switched off
+	MaxSupplyReached bool `protobuf:"varint,10,opt,name=max_supply_reached,json=maxSupplyReached,proto3" json:"max_supply_reached,omitempty"`
+	// multiplier of the scheduled epoch provision in dynamic inflation, one if
+	// not set
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -118,11 +128,25 @@ func (m *GenesisState) GetPeriodMintProvisions() github_com_cosmos_cosmos_sdk_ty
 	return nil
 }
 
-func (m *GenesisState) GetInflationDistribution() InflationDistribution {
+func (m *GenesisState) GetFailedEpochs() []FailedEpoch {
 	if m != nil {
-		return m.InflationDistribution
+		return m.FailedEpochs
 	}
-	return InflationDistribution{}
+	return nil
+}
+
+func (m *GenesisState) GetRemainder() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remainder
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaxSupplyReached() bool {
+	if m != nil {
+		return m.MaxSupplyReached
+	}
+	return false
 }
 
 func init() {
@@ -132,35 +156,44 @@ func init() {
 func init() { proto.RegisterFile("galactica/inflation/genesis.proto", fileDescriptor_f343688383ffae46) }
 
 var fileDescriptor_f343688383ffae46 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd4, 0x30,
-	0x10, 0x5e, 0xb3, 0xcb, 0x22, 0x5c, 0xfe, 0x1a, 0xca, 0x2a, 0x5a, 0x50, 0x1a, 0x40, 0x48, 0xa1,
-	0xa8, 0xb6, 0xda, 0x8a, 0x03, 0xd7, 0x52, 0x54, 0xf5, 0x80, 0x58, 0x2d, 0x37, 0x2e, 0x91, 0x93,
-	0xb8, 0xe9, 0xa8, 0x8d, 0x6d, 0xd9, 0x6e, 0x05, 0x4f, 0xc0, 0x95, 0xe7, 0xe0, 0x49, 0x7a, 0x5c,
-	0x71, 0xe2, 0x04, 0x68, 0xf7, 0x45, 0xd0, 0xda, 0xde, 0x74, 0x0f, 0xb9, 0x24, 0xe3, 0x6f, 0xbe,
-	0x99, 0x6f, 0xfe, 0xf0, 0xf3, 0x9a, 0x5d, 0xb0, 0xd2, 0x42, 0xc9, 0x28, 0x88, 0xd3, 0x0b, 0x66,
-	0x41, 0x0a, 0x5a, 0x73, 0xc1, 0x0d, 0x18, 0xa2, 0xb4, 0xb4, 0x32, 0x7a, 0xdc, 0x52, 0x48, 0x4b,
-	0x19, 0x6f, 0xb2, 0x06, 0x84, 0xa4, 0xee, 0xeb, 0x79, 0xe3, 0xa4, 0x94, 0xa6, 0x91, 0x86, 0x16,
-	0xcc, 0x70, 0x7a, 0xb5, 0x57, 0x70, 0xcb, 0xf6, 0x68, 0x29, 0x41, 0x04, 0xff, 0x56, 0x2d, 0x6b,
-	0xe9, 0x4c, 0xba, 0xb4, 0x02, 0x9a, 0x76, 0x15, 0xa0, 0x98, 0x66, 0x4d, 0xd0, 0x1f, 0xbf, 0xec,
-	0x62, 0xb4, 0x96, 0x27, 0xbd, 0xf8, 0xd5, 0xc7, 0xf7, 0x8e, 0x7d, 0xd9, 0x9f, 0x2d, 0xb3, 0x3c,
-	0x7a, 0x87, 0x87, 0x3e, 0x4b, 0x8c, 0x52, 0x94, 0x6d, 0xec, 0x3f, 0x25, 0x1d, 0x6d, 0x90, 0x89,
-	0xa3, 0x1c, 0x0e, 0xae, 0xff, 0x6c, 0xf7, 0xa6, 0x21, 0x20, 0x1a, 0xe1, 0xa1, 0xe2, 0x1a, 0x64,
-	0x15, 0xdf, 0x4a, 0x51, 0x36, 0x98, 0x86, 0x57, 0xf4, 0x1a, 0x3f, 0xe2, 0x4a, 0x96, 0x67, 0x39,
-	0x54, 0x5c, 0x58, 0x38, 0x05, 0xae, 0xe3, 0x7e, 0x8a, 0xb2, 0xbb, 0xd3, 0x87, 0x0e, 0x3f, 0x69,
-	0xe1, 0x68, 0x07, 0x6f, 0x3a, 0xc8, 0xe4, 0x8a, 0xeb, 0x3c, 0x64, 0x1b, 0xa4, 0x28, 0xeb, 0x07,
-	0xae, 0x99, 0x70, 0x3d, 0xf1, 0x69, 0x5f, 0xe1, 0x07, 0xe6, 0x1c, 0x94, 0xe2, 0x55, 0xee, 0x5d,
-	0xf1, 0x6d, 0x27, 0x7b, 0x3f, 0xa0, 0x1f, 0x1c, 0x18, 0x7d, 0x47, 0x78, 0xe4, 0x13, 0xe5, 0x0d,
-	0x08, 0x9b, 0x2b, 0x2d, 0xaf, 0xc0, 0x80, 0x14, 0x26, 0x1e, 0xa6, 0xfd, 0x6c, 0x63, 0xff, 0x19,
-	0xf1, 0x0b, 0x20, 0xcb, 0x05, 0x90, 0xb0, 0x00, 0x72, 0xc4, 0xcb, 0xf7, 0x12, 0xc4, 0xe1, 0xc1,
-	0xb2, 0xc5, 0x9f, 0x7f, 0xb7, 0xdf, 0xd4, 0x60, 0xcf, 0x2e, 0x0b, 0x52, 0xca, 0x86, 0x86, 0x85,
-	0xf9, 0xdf, 0xae, 0xa9, 0xce, 0xa9, 0xfd, 0xa6, 0xb8, 0x59, 0xc5, 0x98, 0xe9, 0x96, 0x17, 0xfc,
-	0x08, 0xc2, 0x4e, 0x5a, 0xb9, 0xa8, 0xc6, 0xa3, 0x76, 0x82, 0x79, 0x05, 0xc6, 0x6a, 0x28, 0x2e,
-	0x97, 0x8f, 0xf8, 0x8e, 0x1b, 0xf5, 0x4e, 0xe7, 0xa8, 0x4f, 0x56, 0xd6, 0xd1, 0x5a, 0x44, 0x98,
-	0xfc, 0x13, 0xe8, 0x74, 0x7e, 0xba, 0x9e, 0x27, 0x68, 0x36, 0x4f, 0xd0, 0xbf, 0x79, 0x82, 0x7e,
-	0x2c, 0x92, 0xde, 0x6c, 0x91, 0xf4, 0x7e, 0x2f, 0x92, 0xde, 0x97, 0xb7, 0x6b, 0x5d, 0x1c, 0xaf,
-	0xc4, 0x76, 0x4b, 0xa9, 0x15, 0xbd, 0xb9, 0x96, 0xaf, 0x6b, 0xf7, 0xe2, 0x1a, 0x2b, 0x86, 0xee,
-	0x58, 0x0e, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x65, 0x5e, 0x0f, 0xa8, 0xf6, 0x02, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0x69, 0x1a, 0xd2, 0x69, 0x0b, 0xad, 0xa9, 0x2a, 0xb7, 0x45, 0xae, 0x01, 0x21, 0x99,
+	0x47, 0x6d, 0xda, 0x8a, 0x05, 0xdb, 0x50, 0xa8, 0x78, 0x89, 0xe0, 0xee, 0xd8, 0x58, 0x93, 0xf1,
+	0xc4, 0xb9, 0xaa, 0xed, 0x19, 0x79, 0x26, 0x55, 0xf3, 0x05, 0x6c, 0xf9, 0x0e, 0xd6, 0x7c, 0x00,
+	0xcb, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x41, 0xc9, 0x8f, 0x20, 0xcf, 0x4c, 0x9d, 0x2c, 0xb2, 0x60,
+	0x93, 0xf8, 0x9e, 0x7b, 0xe6, 0xdc, 0x39, 0xe7, 0x6a, 0xd0, 0xbd, 0x14, 0x67, 0x98, 0x48, 0x20,
+	0x38, 0x84, 0xa2, 0x9f, 0x61, 0x09, 0xac, 0x08, 0x53, 0x5a, 0x50, 0x01, 0x22, 0xe0, 0x25, 0x93,
+	0xcc, 0xbe, 0x53, 0x53, 0x82, 0x9a, 0xb2, 0xbd, 0x8e, 0x73, 0x28, 0x58, 0xa8, 0x7e, 0x35, 0x6f,
+	0xdb, 0x25, 0x4c, 0xe4, 0x4c, 0x84, 0x3d, 0x2c, 0x68, 0x78, 0xb6, 0xdf, 0xa3, 0x12, 0xef, 0x87,
+	0x84, 0x41, 0x61, 0xfa, 0x5b, 0xba, 0x1f, 0xab, 0x2a, 0xd4, 0x85, 0x69, 0x6d, 0xa4, 0x2c, 0x65,
+	0x1a, 0xaf, 0xbe, 0x0c, 0xfa, 0x60, 0xde, 0xdd, 0xea, 0x2f, 0x43, 0xf2, 0xe6, 0x91, 0x38, 0x2e,
+	0x71, 0x6e, 0xc4, 0xef, 0xff, 0x58, 0x44, 0x2b, 0xc7, 0xda, 0xd1, 0x89, 0xc4, 0x92, 0xda, 0x2f,
+	0x50, 0x4b, 0x13, 0x1c, 0xcb, 0xb3, 0xfc, 0xe5, 0x83, 0x9d, 0x60, 0x8e, 0xc3, 0xa0, 0xab, 0x28,
+	0x9d, 0xe6, 0xc5, 0xd5, 0x6e, 0x23, 0x32, 0x07, 0xec, 0x4d, 0xd4, 0xe2, 0xb4, 0x04, 0x96, 0x38,
+	0x37, 0x3c, 0xcb, 0x6f, 0x46, 0xa6, 0xb2, 0x1f, 0xa1, 0x35, 0xca, 0x19, 0x19, 0xc4, 0x90, 0xd0,
+	0x42, 0x42, 0x1f, 0x68, 0xe9, 0x2c, 0x78, 0x96, 0xbf, 0x14, 0xdd, 0x56, 0xf8, 0x9b, 0x1a, 0xb6,
+	0x1f, 0xa3, 0x75, 0x05, 0x89, 0x98, 0xd3, 0x32, 0x36, 0x6a, 0x4d, 0xcf, 0xf2, 0x17, 0x0c, 0x57,
+	0x74, 0x69, 0xd9, 0xd5, 0xb2, 0x0f, 0xd1, 0x2d, 0x71, 0x0a, 0x9c, 0xd3, 0x24, 0xd6, 0x2d, 0x67,
+	0x51, 0x8d, 0x5d, 0x35, 0xe8, 0x2b, 0x05, 0xda, 0x5f, 0x2c, 0xb4, 0xa9, 0x85, 0xe2, 0x1c, 0x0a,
+	0x59, 0x25, 0x7c, 0x06, 0x02, 0x58, 0x21, 0x9c, 0x96, 0xb7, 0xe0, 0x2f, 0x1f, 0xdc, 0x0d, 0x4c,
+	0xdc, 0xd5, 0x6e, 0x02, 0xb3, 0x9b, 0xe0, 0x88, 0x92, 0x97, 0x0c, 0x8a, 0xce, 0x61, 0x65, 0xf1,
+	0xdb, 0x9f, 0xdd, 0x27, 0x29, 0xc8, 0xc1, 0xb0, 0x17, 0x10, 0x96, 0x9b, 0xf5, 0x98, 0xbf, 0x3d,
+	0x91, 0x9c, 0x86, 0x72, 0xc4, 0xa9, 0xb8, 0x3e, 0x23, 0xa2, 0x0d, 0x3d, 0xf0, 0x03, 0x14, 0xb2,
+	0x5b, 0x8f, 0xb3, 0xdf, 0xa1, 0xd5, 0x3e, 0x86, 0x6c, 0x7a, 0xdf, 0xb6, 0x9a, 0xef, 0xcd, 0x4d,
+	0xf8, 0xb5, 0x62, 0x2a, 0x0f, 0x26, 0xe6, 0x95, 0xfe, 0x14, 0x12, 0x36, 0xa0, 0xa5, 0x92, 0xe6,
+	0x18, 0x8a, 0x84, 0x96, 0xce, 0x92, 0x12, 0xda, 0x9a, 0x6b, 0x44, 0xb9, 0x78, 0x66, 0x5c, 0xf8,
+	0xff, 0xe1, 0x42, 0x5b, 0x98, 0xaa, 0xdb, 0x4f, 0x91, 0x9d, 0xe3, 0xf3, 0x58, 0x0c, 0x39, 0xcf,
+	0x46, 0x71, 0x49, 0x31, 0x19, 0xd0, 0xc4, 0x41, 0x9e, 0xe5, 0xb7, 0xa3, 0xb5, 0x1c, 0x9f, 0x9f,
+	0xa8, 0x46, 0xa4, 0x71, 0xfb, 0x13, 0x42, 0xf9, 0x30, 0x93, 0xc0, 0xb3, 0x6a, 0xcf, 0xcb, 0xd5,
+	0x9e, 0x3b, 0xfb, 0xd5, 0xf8, 0xdf, 0x57, 0xbb, 0x3b, 0x7a, 0x98, 0x48, 0x4e, 0x03, 0x60, 0x61,
+	0x8e, 0xe5, 0x20, 0x78, 0x4f, 0x53, 0x4c, 0x46, 0x47, 0x94, 0xfc, 0xfc, 0xbe, 0x87, 0xcc, 0xfd,
+	0x8f, 0x28, 0x89, 0x66, 0x44, 0xde, 0x36, 0xdb, 0x37, 0xd7, 0xda, 0xd1, 0x66, 0x1d, 0x4e, 0x9c,
+	0x80, 0x90, 0x25, 0xf4, 0x86, 0x55, 0xd1, 0xf9, 0x78, 0x31, 0x76, 0xad, 0xcb, 0xb1, 0x6b, 0xfd,
+	0x1d, 0xbb, 0xd6, 0xd7, 0x89, 0xdb, 0xb8, 0x9c, 0xb8, 0x8d, 0x5f, 0x13, 0xb7, 0xf1, 0xf9, 0xf9,
+	0x8c, 0xdb, 0xe3, 0xeb, 0x8c, 0xf7, 0x08, 0x2b, 0x79, 0x38, 0x7d, 0x18, 0xe7, 0x33, 0x4f, 0x43,
+	0x05, 0xd0, 0x6b, 0xa9, 0xa7, 0x71, 0xf8, 0x6f, 0x00, 0x9e, 0xd2, 0xa4, 0x4f, 0xff, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -184,15 +217,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	{
-		size, err := m.InflationDistribution.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
+	dAtA[i] = 0x5a
+	if m.MaxSupplyReached {
+		i--
+		if m.MaxSupplyReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.FailedEpochs) > 0 {
+		for iNdEx := len(m.FailedEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.PeriodMintProvisions) > 0 {
 		for iNdEx := len(m.PeriodMintProvisions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -280,7 +351,22 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	l = m.InflationDistribution.Size()
+	if len(m.FailedEpochs) > 0 {
+		for _, e := range m.FailedEpochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxSupplyReached {
+		n += 2
+	}
+	l = m.Multiplier.Size()
 	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
@@ -476,9 +562,43 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedEpochs = append(m.FailedEpochs, FailedEpoch{})
+			if err := m.FailedEpochs[len(m.FailedEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationDistribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -505,7 +625,62 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Remainder = append(m.Remainder, types.Coin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MaxSupplyReached = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17,12 +17,66 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/Galactica-corp/galactica/testutil/sample"
 	"github.com/Galactica-corp/galactica/x/inflation/types"
 )
 
+// genesisWith returns the default genesis modified by fn
+func genesisWith(fn func(gs *types.GenesisState)) *types.GenesisState {
+	gs := types.DefaultGenesis()
+	fn(gs)
+	return gs
+}
+
+// distribution returns a distribution with the given validators share and
+// other shares
+func distribution(validatorsShare string, shares ...*types.InflationShare) types.InflationDistribution {
+	return types.InflationDistribution{
+		ValidatorsShare: math.LegacyMustNewDecFromStr(validatorsShare),
+		OtherShares:     shares,
+	}
+}
+
+func share(address, share string) *types.InflationShare {
+	return &types.InflationShare{Address: address, Share: math.LegacyMustNewDecFromStr(share)}
+}
+
+func schedule(initial, decay, floor string) *types.EmissionSchedule {
+	return &types.EmissionSchedule{
+		InitialProvision: math.LegacyMustNewDecFromStr(initial),
+		DecayFactor:      math.LegacyMustNewDecFromStr(decay),
+		FloorProvision:   math.LegacyMustNewDecFromStr(floor),
+	}
+}
+
+// dynamic returns dynamic inflation with the given target bonded ratio,
+// multiplier bounds and change per period
+func dynamic(target, minMultiplier, maxMultiplier, maxChange string) *types.DynamicInflation {
+	return &types.DynamicInflation{
+		TargetBondedRatio:  math.LegacyMustNewDecFromStr(target),
+		MinMultiplier:      math.LegacyMustNewDecFromStr(minMultiplier),
+		MaxMultiplier:      math.LegacyMustNewDecFromStr(maxMultiplier),
+		MaxChangePerPeriod: math.LegacyMustNewDecFromStr(maxChange),
+	}
+}
+
+func failedEpoch(epochNumber int64) types.FailedEpoch {
+	return types.FailedEpoch{
+		EpochNumber:     epochNumber,
+		EpochIdentifier: "day",
+		Amount:          sdk.NewInt64Coin("gnet", 100),
+		Reason:          "insufficient funds",
+		Height:          10,
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
+	addr1, addr2 := sample.AccAddress(), sample.AccAddress()
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -34,14 +88,326 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
-			genState: &types.GenesisState{
-
+			desc: "valid genesis state",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5", share(addr1, "0.3"), share(addr2, "0.2"))
 				// this line is used by starport scaffolding # types/genesis/validField
-			},
+			}),
+			valid: true,
+		},
+		{
+			desc:     "empty genesis state",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "shares sum below one",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5", share(addr1, "0.3"))
+			}),
+			valid: true,
+		},
+		{
+			desc: "shares sum above one",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.8", share(addr1, "0.3"))
+			}),
+			valid: false,
+		},
+		{
+			desc: "share above one",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("1.5")
+			}),
+			valid: false,
+		},
+		{
+			desc: "negative share",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("1", share(addr1, "-0.1"))
+			}),
+			valid: false,
+		},
+		{
+			desc: "module, community pool and burn shares",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5",
+					&types.InflationShare{Kind: types.RecipientKindModule, ModuleName: "gov", Share: math.LegacyMustNewDecFromStr("0.1")},
+					&types.InflationShare{Kind: types.RecipientKindCommunityPool, Share: math.LegacyMustNewDecFromStr("0.1")},
+					&types.InflationShare{Kind: types.RecipientKindBurn, Share: math.LegacyMustNewDecFromStr("0.1")},
+				)
+			}),
 			valid: true,
 		},
+		{
+			desc: "duplicate burn share",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5",
+					&types.InflationShare{Kind: types.RecipientKindBurn, Share: math.LegacyMustNewDecFromStr("0.1")},
+					&types.InflationShare{Kind: types.RecipientKindBurn, Share: math.LegacyMustNewDecFromStr("0.1")},
+				)
+			}),
+			valid: false,
+		},
+		{
+			desc: "module share without module name",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5",
+					&types.InflationShare{Kind: types.RecipientKindModule, Share: math.LegacyMustNewDecFromStr("0.1")},
+				)
+			}),
+			valid: false,
+		},
+		{
+			desc: "module share paid to the inflation module",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5",
+					&types.InflationShare{Kind: types.RecipientKindModule, ModuleName: types.ModuleName, Share: math.LegacyMustNewDecFromStr("0.1")},
+				)
+			}),
+			valid: false,
+		},
+		{
+			desc: "burn share with address",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5",
+					&types.InflationShare{Kind: types.RecipientKindBurn, Address: addr1, Share: math.LegacyMustNewDecFromStr("0.1")},
+				)
+			}),
+			valid: false,
+		},
+		{
+			desc: "unknown recipient kind",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5",
+					&types.InflationShare{Kind: types.RecipientKind(9), Share: math.LegacyMustNewDecFromStr("0.1")},
+				)
+			}),
+			valid: false,
+		},
+		{
+			desc: "invalid share address",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5", share("invalid", "0.1"))
+			}),
+			valid: false,
+		},
+		{
+			desc: "duplicate share address",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.InflationDistribution = distribution("0.5", share(addr1, "0.1"), share(addr1, "0.1"))
+			}),
+			valid: false,
+		},
+		{
+			desc: "empty mint denom",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.MintDenom = ""
+			}),
+			valid: false,
+		},
+		{
+			desc: "blank epoch identifier",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.EpochIdentifier = " "
+			}),
+			valid: false,
+		},
+		{
+			desc: "zero epochs per period",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.EpochsPerPeriod = 0
+			}),
+			valid: false,
+		},
+		{
+			desc: "invalid period mint provision",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.PeriodMintProvisions = []sdk.DecCoin{{Denom: "gnet", Amount: math.LegacyNewDec(-1)}}
+			}),
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
+		{
+			desc: "valid failed epochs",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.FailedEpochs = []types.FailedEpoch{failedEpoch(1), failedEpoch(2)}
+			}),
+			valid: true,
+		},
+		{
+			desc: "duplicated failed epoch",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.FailedEpochs = []types.FailedEpoch{failedEpoch(1), failedEpoch(1)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "failed epoch without epoch number",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.FailedEpochs = []types.FailedEpoch{failedEpoch(0)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "failed epoch without identifier",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				failed := failedEpoch(1)
+				failed.EpochIdentifier = ""
+				gs.FailedEpochs = []types.FailedEpoch{failed}
+			}),
+			valid: false,
+		},
+		{
+			desc: "failed epoch without amount",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				failed := failedEpoch(1)
+				failed.Amount = sdk.NewInt64Coin("gnet", 0)
+				gs.FailedEpochs = []types.FailedEpoch{failed}
+			}),
+			valid: false,
+		},
+		{
+			desc: "failed epoch without reason",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				failed := failedEpoch(1)
+				failed.Reason = " "
+				gs.FailedEpochs = []types.FailedEpoch{failed}
+			}),
+			valid: false,
+		},
+		{
+			desc: "carried remainder",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.RemainderPolicy = types.RemainderPolicyCarry
+				gs.Remainder = sdk.NewCoins(sdk.NewInt64Coin("gnet", 3))
+			}),
+			valid: true,
+		},
+		{
+			desc: "zero remainder",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Remainder = sdk.Coins{sdk.NewInt64Coin("gnet", 0)}
+			}),
+			valid: false,
+		},
+		{
+			desc: "unknown remainder policy",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.RemainderPolicy = types.RemainderPolicy(4)
+			}),
+			valid: false,
+		},
+		{
+			desc: "emission schedule",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.EmissionSchedule = schedule("1000", "0.9", "100")
+				gs.Params.MaxSupply = math.NewInt(1_000_000)
+			}),
+			valid: true,
+		},
+		{
+			desc: "emission schedule without initial provision",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.EmissionSchedule = schedule("0", "0.9", "0")
+			}),
+			valid: false,
+		},
+		{
+			desc: "emission schedule with zero decay factor",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.EmissionSchedule = schedule("1000", "0", "0")
+			}),
+			valid: false,
+		},
+		{
+			desc: "growing emission schedule",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.EmissionSchedule = schedule("1000", "1.1", "0")
+			}),
+			valid: false,
+		},
+		{
+			desc: "emission schedule with negative floor",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.EmissionSchedule = schedule("1000", "0.9", "-1")
+			}),
+			valid: false,
+		},
+		{
+			desc: "negative max supply",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.MaxSupply = math.NewInt(-1)
+			}),
+			valid: false,
+		},
+		{
+			desc: "max supply reached",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.EnableInflation = false
+				gs.Params.MaxSupply = math.NewInt(1_000_000)
+				gs.MaxSupplyReached = true
+			}),
+			valid: true,
+		},
+		{
+			desc: "dynamic inflation",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.DynamicInflation = dynamic("0.67", "0.5", "2", "0.2")
+				gs.Multiplier = math.LegacyMustNewDecFromStr("1.5")
+			}),
+			valid: true,
+		},
+		{
+			desc: "dynamic inflation without target bonded ratio",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.DynamicInflation = dynamic("0", "0.5", "2", "0.2")
+			}),
+			valid: false,
+		},
+		{
+			desc: "dynamic inflation with target bonded ratio above one",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.DynamicInflation = dynamic("1.1", "0.5", "2", "0.2")
+			}),
+			valid: false,
+		},
+		{
+			desc: "dynamic inflation with negative min multiplier",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.DynamicInflation = dynamic("0.67", "-0.5", "2", "0.2")
+			}),
+			valid: false,
+		},
+		{
+			desc: "dynamic inflation with max multiplier below min multiplier",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.DynamicInflation = dynamic("0.67", "2", "0.5", "0.2")
+			}),
+			valid: false,
+		},
+		{
+			desc: "dynamic inflation with negative max change",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.DynamicInflation = dynamic("0.67", "0.5", "2", "-0.2")
+			}),
+			valid: false,
+		},
+		{
+			desc: "negative multiplier",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Multiplier = math.LegacyNewDec(-1)
+			}),
+			valid: false,
+		},
+		{
+			desc: "inflation enabled after the max supply was reached",
+			genState: genesisWith(func(gs *types.GenesisState) {
+				gs.Params.MaxSupply = math.NewInt(1_000_000)
+				gs.MaxSupplyReached = true
+			}),
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
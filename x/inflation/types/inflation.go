@@ -16,7 +16,219 @@
 
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// RecipientCommunityPool is the recipient of community pool shares
+	RecipientCommunityPool = "community_pool"
+	// RecipientBurn is the recipient of burn shares
+	RecipientBurn = "burn"
+	// RecipientModulePrefix prefixes the module name of module shares
+	RecipientModulePrefix = "module:"
+	// RecipientValidators is the recipient of the validators share in
+	// emission simulations
+	RecipientValidators = "validators"
+)
+
+// NewInflationShare returns a share paid to the recipient, which is an account
+// address, "module:<name>", "community_pool" or "burn"
+func NewInflationShare(recipient, name string, share math.LegacyDec) InflationShare {
+	s := InflationShare{Name: name, Share: share}
+	switch {
+	case recipient == RecipientCommunityPool:
+		s.Kind = RecipientKindCommunityPool
+	case recipient == RecipientBurn:
+		s.Kind = RecipientKindBurn
+	case strings.HasPrefix(recipient, RecipientModulePrefix):
+		s.Kind = RecipientKindModule
+		s.ModuleName = strings.TrimPrefix(recipient, RecipientModulePrefix)
+	default:
+		s.Kind = RecipientKindAccount
+		s.Address = recipient
+	}
+	return s
+}
+
+// Equal returns true if both distributions have equal shares in the same
+// order
 func (d InflationDistribution) Equal(d2 *InflationDistribution) bool {
-	// todo
-	return false
+	if d2 == nil {
+		return false
+	}
+	if !decEqual(d.ValidatorsShare, d2.ValidatorsShare) || len(d.OtherShares) != len(d2.OtherShares) {
+		return false
+	}
+	for i, share := range d.OtherShares {
+		if !share.Equal(d2.OtherShares[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if both shares have the same recipient, name and share
+func (s *InflationShare) Equal(s2 *InflationShare) bool {
+	if s == nil || s2 == nil {
+		return s == s2
+	}
+	return s.Kind == s2.Kind && s.Address == s2.Address && s.ModuleName == s2.ModuleName &&
+		s.Name == s2.Name && decEqual(s.Share, s2.Share)
+}
+
+// Recipient returns the recipient of the share in the format accepted by
+// NewInflationShare
+func (s InflationShare) Recipient() string {
+	switch s.Kind {
+	case RecipientKindModule:
+		return RecipientModulePrefix + s.ModuleName
+	case RecipientKindCommunityPool:
+		return RecipientCommunityPool
+	case RecipientKindBurn:
+		return RecipientBurn
+	default:
+		return s.Address
+	}
+}
+
+// decEqual compares two decimals that may be nil
+func decEqual(a, b math.LegacyDec) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() == b.IsNil()
+	}
+	return a.Equal(b)
+}
+
+// TotalShare returns the sum of the validators share and all other shares
+func (d InflationDistribution) TotalShare() math.LegacyDec {
+	total := d.ValidatorsShare
+	for _, share := range d.OtherShares {
+		total = total.Add(share.Share)
+	}
+	return total
+}
+
+// FindShare returns the index of the other share paid to the recipient
+func (d InflationDistribution) FindShare(recipient string) (int, bool) {
+	for i, share := range d.OtherShares {
+		if share.Recipient() == recipient {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// ValidateBasic checks that the share is paid to a valid recipient and lies
+// within [0, 1]
+func (s InflationShare) ValidateBasic() error {
+	if err := s.ValidateRecipient(); err != nil {
+		return err
+	}
+	return ValidateShare(s.Share)
+}
+
+// ValidateRecipient checks that exactly the fields of the recipient kind are
+// set
+func (s InflationShare) ValidateRecipient() error {
+	switch s.Kind {
+	case RecipientKindAccount:
+		if _, err := sdk.AccAddressFromBech32(s.Address); err != nil {
+			return fmt.Errorf("invalid address of inflation share %s: %w", s.Name, err)
+		}
+		if s.ModuleName != "" {
+			return fmt.Errorf("account share %s must not set a module name", s.Name)
+		}
+	case RecipientKindModule:
+		if strings.TrimSpace(s.ModuleName) == "" {
+			return fmt.Errorf("module share %s must set a module name", s.Name)
+		}
+		if s.ModuleName == ModuleName {
+			return fmt.Errorf("module share %s must not be paid to the %s module", s.Name, ModuleName)
+		}
+		if s.Address != "" {
+			return fmt.Errorf("module share %s must not set an address", s.Name)
+		}
+	case RecipientKindCommunityPool, RecipientKindBurn:
+		if s.Address != "" || s.ModuleName != "" {
+			return fmt.Errorf("%s share %s must not set an address or a module name", s.Recipient(), s.Name)
+		}
+	default:
+		return fmt.Errorf("unknown recipient kind of inflation share %s: %d", s.Name, s.Kind)
+	}
+	return nil
+}
+
+// ValidateShare checks that a share lies within [0, 1]
+func ValidateShare(share math.LegacyDec) error {
+	if share.IsNil() || share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("share must be within [0, 1]: %s", share)
+	}
+	return nil
+}
+
+// Validate checks that the shares are valid and that every other share is
+// paid to a distinct valid recipient
+func (d InflationDistribution) Validate() error {
+	if err := d.ValidateShares(); err != nil {
+		return err
+	}
+
+	recipients := make(map[string]bool, len(d.OtherShares))
+	for _, share := range d.OtherShares {
+		if err := share.ValidateRecipient(); err != nil {
+			return err
+		}
+		if recipients[share.Recipient()] {
+			return fmt.Errorf("duplicated inflation share recipient %s", share.Recipient())
+		}
+		recipients[share.Recipient()] = true
+	}
+	return nil
+}
+
+// ValidateShares checks that all shares of the distribution are set, not
+// negative and sum up to at most 1
+func (d InflationDistribution) ValidateShares() error {
+	if d.ValidatorsShare.IsNil() || d.ValidatorsShare.IsNegative() {
+		return fmt.Errorf("invalid validators share: %s", d.ValidatorsShare)
+	}
+
+	total := d.ValidatorsShare
+	for _, share := range d.OtherShares {
+		if share == nil || share.Share.IsNil() || share.Share.IsNegative() {
+			return fmt.Errorf("invalid inflation share: %v", share)
+		}
+		total = total.Add(share.Share)
+	}
+
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("inflation shares sum up to more than 1: %s", total)
+	}
+	return nil
+}
+
+// Validate checks that the failed epoch has a positive epoch number and
+// amount and a reason
+func (e FailedEpoch) Validate() error {
+	if e.EpochNumber <= 0 {
+		return fmt.Errorf("epoch number of failed epoch must be positive: %d", e.EpochNumber)
+	}
+	if strings.TrimSpace(e.EpochIdentifier) == "" {
+		return fmt.Errorf("epoch identifier of failed epoch %d must not be empty", e.EpochNumber)
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount of failed epoch %d: %w", e.EpochNumber, err)
+	}
+	if !e.Amount.IsPositive() {
+		return fmt.Errorf("amount of failed epoch %d must be positive: %s", e.EpochNumber, e.Amount)
+	}
+	if strings.TrimSpace(e.Reason) == "" {
+		return fmt.Errorf("reason of failed epoch %d must not be empty", e.EpochNumber)
+	}
+	return nil
 }
@@ -4,9 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	// github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	sdkmath "cosmossdk.io/math"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -25,11 +25,53 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RecipientKind defines where the coins of an inflation share are sent to.
+type RecipientKind int32
+
+const (
+	// RECIPIENT_KIND_ACCOUNT shares are sent to the account at address.
+	RecipientKindAccount RecipientKind = 0
+	// RECIPIENT_KIND_MODULE shares are sent to the module account named
+	// module_name.
+	RecipientKindModule RecipientKind = 1
+	// RECIPIENT_KIND_COMMUNITY_POOL shares fund the community pool of x/distribution.
+	RecipientKindCommunityPool RecipientKind = 2
+	// RECIPIENT_KIND_BURN shares are burned.
+	RecipientKindBurn RecipientKind = 3
+)
+
+var RecipientKind_name = map[int32]string{
+	0: "RECIPIENT_KIND_ACCOUNT",
+	1: "RECIPIENT_KIND_MODULE",
+	2: "RECIPIENT_KIND_COMMUNITY_POOL",
+	3: "RECIPIENT_KIND_BURN",
+}
+
+var RecipientKind_value = map[string]int32{
+	"RECIPIENT_KIND_ACCOUNT":        0,
+	"RECIPIENT_KIND_MODULE":         1,
+	"RECIPIENT_KIND_COMMUNITY_POOL": 2,
+	"RECIPIENT_KIND_BURN":           3,
+}
+
+func (x RecipientKind) String() string {
+	return proto.EnumName(RecipientKind_name, int32(x))
+}
+
+func (RecipientKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ed51ddc8e234630c, []int{0}
+}
+
 // InflationShare represents the share information for various roles other than validators.
 type InflationShare struct {
-	Address string                                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Name    string                                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Share   sdkmath.LegacyDec `protobuf:"bytes,3,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	// address of the recipient account, only set for account shares
+	Address string                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Share   cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+	// kind of the recipient, defaults to an account
+	Kind RecipientKind `protobuf:"varint,4,opt,name=kind,proto3,enum=galactica.inflation.RecipientKind" json:"kind,omitempty"`
+	// module_name of the recipient module account, only set for module shares
+	ModuleName string `protobuf:"bytes,5,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
 }
 
 func (m *InflationShare) Reset()         { *m = InflationShare{} }
@@ -79,11 +121,25 @@ func (m *InflationShare) GetName() string {
 	return ""
 }
 
+func (m *InflationShare) GetKind() RecipientKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RecipientKindAccount
+}
+
+func (m *InflationShare) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
 // InflationDistribution defines the distribution in which inflation is
 // allocated through minting on each epoch (staking, incentives, community).
 type InflationDistribution struct {
-	ValidatorsShare sdkmath.LegacyDec `protobuf:"bytes,1,opt,name=validators_share,json=validatorsShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validators_share"`
-	OtherShares     []*InflationShare                      `protobuf:"bytes,2,rep,name=other_shares,json=otherShares,proto3" json:"other_shares,omitempty"`
+	ValidatorsShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=validators_share,json=validatorsShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_share"`
+	OtherShares     []*InflationShare           `protobuf:"bytes,2,rep,name=other_shares,json=otherShares,proto3" json:"other_shares,omitempty"`
 }
 
 func (m *InflationDistribution) Reset()         { *m = InflationDistribution{} }
@@ -126,9 +182,94 @@ func (m *InflationDistribution) GetOtherShares() []*InflationShare {
 	return nil
 }
 
+// FailedEpoch records an epoch whose inflation could not be minted and
+// allocated. Nothing is minted for a failed epoch until it is retried.
+type FailedEpoch struct {
+	// epoch_number of the failed epoch
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// epoch_identifier of the failed epoch
+	EpochIdentifier string `protobuf:"bytes,2,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// amount that was to be minted in the epoch
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// reason the mint or allocation failed
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// height of the block the epoch started in
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *FailedEpoch) Reset()         { *m = FailedEpoch{} }
+func (m *FailedEpoch) String() string { return proto.CompactTextString(m) }
+func (*FailedEpoch) ProtoMessage()    {}
+func (*FailedEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed51ddc8e234630c, []int{2}
+}
+func (m *FailedEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedEpoch.Merge(m, src)
+}
+func (m *FailedEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedEpoch proto.InternalMessageInfo
+
+func (m *FailedEpoch) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *FailedEpoch) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *FailedEpoch) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *FailedEpoch) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *FailedEpoch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("galactica.inflation.RecipientKind", RecipientKind_name, RecipientKind_value)
 	proto.RegisterType((*InflationShare)(nil), "galactica.inflation.InflationShare")
 	proto.RegisterType((*InflationDistribution)(nil), "galactica.inflation.InflationDistribution")
+	proto.RegisterType((*FailedEpoch)(nil), "galactica.inflation.FailedEpoch")
 }
 
 func init() {
@@ -136,27 +277,47 @@ func init() {
 }
 
 var fileDescriptor_ed51ddc8e234630c = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0x4f, 0xcc, 0x49,
-	0x4c, 0x2e, 0xc9, 0x4c, 0x4e, 0xd4, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x43,
-	0xb0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84, 0xe1, 0x8a, 0xf4, 0xe0, 0x52, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x79, 0x7d, 0x10, 0x0b, 0xa2, 0x54, 0xa9, 0x85, 0x91, 0x8b, 0xcf,
-	0x13, 0xa6, 0x26, 0x38, 0x23, 0xb1, 0x28, 0x55, 0x48, 0x82, 0x8b, 0x3d, 0x31, 0x25, 0xa5, 0x28,
-	0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xc6, 0x15, 0x12, 0xe2, 0x62, 0xc9,
-	0x4b, 0xcc, 0x4d, 0x95, 0x60, 0x02, 0x0b, 0x83, 0xd9, 0x42, 0x2e, 0x5c, 0xac, 0xc5, 0x20, 0x6d,
-	0x12, 0xcc, 0x20, 0x41, 0x27, 0xbd, 0x13, 0xf7, 0xe4, 0x19, 0x6e, 0xdd, 0x93, 0x57, 0x4b, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x86,
-	0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x7a, 0x2e, 0xa9, 0xc9, 0x41,
-	0x10, 0xcd, 0x4a, 0xbb, 0x18, 0xb9, 0x44, 0xe1, 0xce, 0x70, 0xc9, 0x2c, 0x2e, 0x29, 0xca, 0x4c,
-	0x2a, 0x05, 0xb1, 0x85, 0x22, 0xb9, 0x04, 0xca, 0x12, 0x73, 0x32, 0x53, 0x12, 0x4b, 0xf2, 0x8b,
-	0x8a, 0xe3, 0x21, 0x56, 0x31, 0x92, 0x65, 0x15, 0x3f, 0xc2, 0x1c, 0x88, 0x47, 0xdd, 0xb8, 0x78,
-	0xf2, 0x4b, 0x32, 0x52, 0x8b, 0x20, 0xa6, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x29,
-	0xeb, 0x61, 0x09, 0x3d, 0x3d, 0xd4, 0x30, 0x0a, 0xe2, 0x06, 0x6b, 0x04, 0xb3, 0x8b, 0x9d, 0xfc,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x14, 0xc9, 0x69, 0xee, 0x30,
-	0x53, 0x75, 0x93, 0xf3, 0x8b, 0x0a, 0xf4, 0x11, 0xf1, 0x58, 0x81, 0x14, 0x93, 0x60, 0xd7, 0x26,
-	0xb1, 0x81, 0xe3, 0xc6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x92, 0xf6, 0x5d, 0xdf, 0xed, 0x01,
-	0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcb, 0x4e, 0xdb, 0x4c,
+	0x18, 0x8d, 0x49, 0xe0, 0x17, 0x13, 0x7e, 0x48, 0x87, 0x4b, 0x5d, 0x57, 0x35, 0x6e, 0xd8, 0xd0,
+	0x4a, 0xb5, 0x45, 0x7a, 0x53, 0x97, 0xe4, 0x42, 0x15, 0x41, 0x1c, 0xe4, 0x92, 0x45, 0xbb, 0x89,
+	0x26, 0xe3, 0x21, 0x1e, 0x11, 0xcf, 0x44, 0x9e, 0x31, 0x2a, 0x6f, 0x50, 0x65, 0xd5, 0x17, 0xc8,
+	0xaa, 0x0f, 0xd0, 0x97, 0xe8, 0x82, 0x25, 0xea, 0xaa, 0xea, 0x02, 0x55, 0xf0, 0x06, 0x7d, 0x82,
+	0xca, 0xe3, 0x10, 0xea, 0x88, 0x45, 0x77, 0x67, 0x8e, 0xbf, 0xf3, 0xf9, 0x7c, 0x37, 0xb0, 0xd5,
+	0x47, 0x03, 0x84, 0x25, 0xc5, 0xc8, 0xa1, 0xec, 0x78, 0x80, 0x24, 0xe5, 0xec, 0x16, 0xd9, 0xc3,
+	0x88, 0x4b, 0x0e, 0x57, 0xa7, 0x41, 0xf6, 0xf4, 0x93, 0x61, 0x62, 0x2e, 0x42, 0x2e, 0x9c, 0x1e,
+	0x12, 0xc4, 0x39, 0xdd, 0xe9, 0x11, 0x89, 0x76, 0x1c, 0xcc, 0xe9, 0x44, 0x64, 0xac, 0xf5, 0x79,
+	0x9f, 0x2b, 0xe8, 0x24, 0x28, 0x65, 0xcb, 0xdf, 0x35, 0xb0, 0xdc, 0xbc, 0xc9, 0xf1, 0x2e, 0x40,
+	0x11, 0x81, 0x3a, 0xf8, 0x0f, 0xf9, 0x7e, 0x44, 0x84, 0xd0, 0x35, 0x4b, 0xdb, 0x5e, 0xf4, 0x6e,
+	0x9e, 0x10, 0x82, 0x02, 0x43, 0x21, 0xd1, 0xe7, 0x14, 0xad, 0x30, 0x7c, 0x03, 0xe6, 0x45, 0x22,
+	0xd3, 0xf3, 0x09, 0x59, 0xdd, 0x3a, 0xbf, 0xdc, 0xcc, 0xfd, 0xbc, 0xdc, 0x7c, 0x98, 0xba, 0x11,
+	0xfe, 0x89, 0x4d, 0xb9, 0x13, 0x22, 0x19, 0xd8, 0x07, 0xa4, 0x8f, 0xf0, 0x59, 0x9d, 0x60, 0x2f,
+	0x55, 0xc0, 0x57, 0xa0, 0x70, 0x42, 0x99, 0xaf, 0x17, 0x2c, 0x6d, 0x7b, 0xb9, 0x52, 0xb6, 0xef,
+	0xa8, 0xca, 0xf6, 0x08, 0xa6, 0x43, 0x4a, 0x98, 0xdc, 0xa7, 0xcc, 0xf7, 0x54, 0x3c, 0xdc, 0x04,
+	0xc5, 0x90, 0xfb, 0xf1, 0x80, 0x74, 0x95, 0x9b, 0x79, 0xe5, 0x06, 0xa4, 0x94, 0x8b, 0x42, 0x52,
+	0xfe, 0xaa, 0x81, 0xf5, 0x69, 0x51, 0x75, 0x2a, 0x64, 0x44, 0x7b, 0x71, 0x82, 0xa1, 0x0b, 0x4a,
+	0xa7, 0x68, 0x40, 0x7d, 0x24, 0x79, 0x24, 0xba, 0xa9, 0x71, 0xed, 0xdf, 0x8d, 0xaf, 0xdc, 0x8a,
+	0xd3, 0x5e, 0xed, 0x81, 0x25, 0x2e, 0x03, 0x12, 0xa5, 0xa9, 0x84, 0x3e, 0x67, 0xe5, 0xb7, 0x8b,
+	0x95, 0xad, 0x3b, 0x4b, 0xc9, 0xb6, 0xd9, 0x2b, 0x2a, 0xa1, 0xc2, 0xa2, 0xfc, 0x4d, 0x03, 0xc5,
+	0x3d, 0x44, 0x07, 0xc4, 0x6f, 0x0c, 0x39, 0x0e, 0xe0, 0x63, 0xb0, 0x44, 0x12, 0xd0, 0x65, 0x71,
+	0xd8, 0x23, 0x91, 0xf2, 0x98, 0xf7, 0x8a, 0x8a, 0x73, 0x15, 0x05, 0x9f, 0x80, 0x52, 0x1a, 0x42,
+	0x7d, 0xc2, 0x24, 0x3d, 0xa6, 0x24, 0x9a, 0x0c, 0x66, 0x45, 0xf1, 0xcd, 0x29, 0x0d, 0x5f, 0x83,
+	0x05, 0x14, 0xf2, 0x98, 0x49, 0x35, 0xa4, 0x62, 0xe5, 0x81, 0x9d, 0x16, 0x69, 0x27, 0xbb, 0x62,
+	0x4f, 0x76, 0xc5, 0xae, 0x71, 0xca, 0xaa, 0x85, 0xa4, 0x0d, 0xde, 0x24, 0x1c, 0x6e, 0x80, 0x85,
+	0x88, 0x20, 0xc1, 0x99, 0x9a, 0xd1, 0xa2, 0x37, 0x79, 0x25, 0x7c, 0x40, 0x68, 0x3f, 0x90, 0xaa,
+	0xf9, 0x79, 0x6f, 0xf2, 0x7a, 0xfa, 0x5b, 0x03, 0xff, 0x67, 0x26, 0x06, 0x5f, 0x80, 0x0d, 0xaf,
+	0x51, 0x6b, 0x1e, 0x36, 0x1b, 0xee, 0x51, 0x77, 0xbf, 0xe9, 0xd6, 0xbb, 0xbb, 0xb5, 0x5a, 0xbb,
+	0xe3, 0x1e, 0x95, 0x72, 0x86, 0x3e, 0x1a, 0x5b, 0x6b, 0x99, 0xf0, 0x5d, 0x8c, 0xd5, 0x7f, 0x2b,
+	0x60, 0x7d, 0x46, 0xd5, 0x6a, 0xd7, 0x3b, 0x07, 0x8d, 0x92, 0x66, 0xdc, 0x1f, 0x8d, 0xad, 0xd5,
+	0x8c, 0xa8, 0xa5, 0x06, 0x0f, 0x77, 0xc1, 0xa3, 0x19, 0x4d, 0xad, 0xdd, 0x6a, 0x75, 0xdc, 0xe6,
+	0xd1, 0xfb, 0xee, 0x61, 0xbb, 0x7d, 0x50, 0x9a, 0x33, 0xcc, 0xd1, 0xd8, 0x32, 0x32, 0xda, 0x1a,
+	0x0f, 0xc3, 0x98, 0x51, 0x79, 0x76, 0xc8, 0xf9, 0x00, 0xda, 0x60, 0x75, 0x26, 0x45, 0xb5, 0xe3,
+	0xb9, 0xa5, 0xbc, 0xb1, 0x3e, 0x1a, 0x5b, 0xf7, 0x32, 0xc2, 0x6a, 0x1c, 0x31, 0xa3, 0xf0, 0xe9,
+	0x8b, 0x99, 0xab, 0xb6, 0xcf, 0xaf, 0x4c, 0xed, 0xe2, 0xca, 0xd4, 0x7e, 0x5d, 0x99, 0xda, 0xe7,
+	0x6b, 0x33, 0x77, 0x71, 0x6d, 0xe6, 0x7e, 0x5c, 0x9b, 0xb9, 0x0f, 0x2f, 0xfb, 0x54, 0x06, 0x71,
+	0xcf, 0xc6, 0x3c, 0x74, 0xde, 0xde, 0x6c, 0xc4, 0x33, 0xcc, 0xa3, 0xa1, 0x73, 0x7b, 0xe6, 0x1f,
+	0xff, 0x3a, 0x74, 0x79, 0x36, 0x24, 0xa2, 0xb7, 0xa0, 0x4e, 0xf3, 0xf9, 0x9f, 0x01, 0x00, 0x93,
+	0xc3, 0xa0, 0xc3, 0x0c, 0x04, 0x00, 0x00,
 }
 
 func (m *InflationShare) Marshal() (dAtA []byte, err error) {
@@ -179,6 +340,18 @@ func (m *InflationShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Share.Size()
 		i -= size
@@ -253,6 +426,63 @@ func (m *InflationDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FailedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInflation(v)
 	base := offset
@@ -280,6 +510,13 @@ func (m *InflationShare) Size() (n int) {
 	}
 	l = m.Share.Size()
 	n += 1 + l + sovInflation(uint64(l))
+	if m.Kind != 0 {
+		n += 1 + sovInflation(uint64(m.Kind))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
 	return n
 }
 
@@ -300,6 +537,31 @@ func (m *InflationDistribution) Size() (n int) {
 	return n
 }
 
+func (m *FailedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovInflation(uint64(m.EpochNumber))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovInflation(uint64(m.Height))
+	}
+	return n
+}
+
 func sovInflation(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -433,6 +695,57 @@ func (m *InflationShare) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= RecipientKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInflation(dAtA[iNdEx:])
@@ -572,6 +885,191 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FailedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInflation(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21,13 +21,14 @@ import (
 	"cosmossdk.io/math"
 )
 
-// CalculateEpochMintProvision returns mint provision per epoch
+// CalculateEpochMintProvision returns mint provision per epoch, zero once the
+// period passes the end of the period mint provisions
 func CalculateEpochMintProvision(
 	periodMintProvisions sdk.DecCoins,
 	period uint64,
 	epochsPerPeriod int64,
 ) math.LegacyDec {
-	if period > uint64(len(periodMintProvisions)) {
+	if epochsPerPeriod <= 0 || period >= uint64(len(periodMintProvisions)) {
 		return math.LegacyZeroDec()
 	}
 
@@ -40,3 +41,36 @@ func CalculateEpochMintProvision(
 	// epochProvision = epochProvision.Mul(sdk.NewDecFromInt(sdk.DefaultPowerReduction))
 	return epochProvision
 }
+
+// CalculatePeriodMintProvision returns the mint provision of a period of the
+// emission schedule
+func CalculatePeriodMintProvision(schedule EmissionSchedule, period uint64) math.LegacyDec {
+	provision := schedule.InitialProvision.Mul(schedule.DecayFactor.Power(period))
+	return math.LegacyMaxDec(provision, schedule.FloorProvision)
+}
+
+// CalculateMultiplier returns the multiplier of the next epoch in dynamic
+// inflation. The multiplier changes by up to max_change_per_period over the
+// epochs of a period in proportion to the deviation of the bonded ratio from
+// the target, which is capped to one, and is kept within the bounds:
+//
+//	multiplier + (1 - bondedRatio/target) * maxChangePerPeriod / epochsPerPeriod
+func CalculateMultiplier(
+	dynamic DynamicInflation,
+	multiplier math.LegacyDec,
+	bondedRatio math.LegacyDec,
+	epochsPerPeriod int64,
+) math.LegacyDec {
+	if epochsPerPeriod > 0 {
+		deviation := math.LegacyOneDec().Sub(bondedRatio.Quo(dynamic.TargetBondedRatio))
+		deviation = math.LegacyMinDec(math.LegacyMaxDec(deviation, math.LegacyNewDec(-1)), math.LegacyOneDec())
+		multiplier = multiplier.Add(deviation.Mul(dynamic.MaxChangePerPeriod).QuoInt64(epochsPerPeriod))
+	}
+	return dynamic.ClampMultiplier(multiplier)
+}
+
+// ClampMultiplier returns the multiplier within the bounds of the dynamic
+// inflation
+func (d DynamicInflation) ClampMultiplier(multiplier math.LegacyDec) math.LegacyDec {
+	return math.LegacyMinDec(math.LegacyMaxDec(multiplier, d.MinMultiplier), d.MaxMultiplier)
+}
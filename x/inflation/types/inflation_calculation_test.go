@@ -0,0 +1,99 @@
+// Copyright 2024 Galactica Network
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Galactica-corp/galactica/x/inflation/types"
+)
+
+func TestCalculateEpochMintProvision(t *testing.T) {
+	provisions := sdk.DecCoins{
+		sdk.NewDecCoin("gnet", math.NewInt(365)),
+		sdk.NewDecCoin("gnet", math.NewInt(730)),
+	}
+
+	require.Equal(t, math.LegacyNewDec(1), types.CalculateEpochMintProvision(provisions, 0, 365))
+	require.Equal(t, math.LegacyNewDec(2), types.CalculateEpochMintProvision(provisions, 1, 365))
+
+	// nothing is minted after the last period
+	require.True(t, types.CalculateEpochMintProvision(provisions, 2, 365).IsZero())
+	require.True(t, types.CalculateEpochMintProvision(provisions, 3, 365).IsZero())
+	require.True(t, types.CalculateEpochMintProvision(provisions, 0, 0).IsZero())
+}
+
+func TestCalculatePeriodMintProvision(t *testing.T) {
+	schedule := types.EmissionSchedule{
+		InitialProvision: math.LegacyNewDec(1000),
+		DecayFactor:      math.LegacyMustNewDecFromStr("0.5"),
+		FloorProvision:   math.LegacyNewDec(100),
+	}
+
+	for period, expected := range []int64{1000, 500, 250, 125, 100, 100} {
+		require.Equal(t, math.LegacyNewDec(expected), types.CalculatePeriodMintProvision(schedule, uint64(period)), "period %d", period)
+	}
+
+	// without a floor the provision decays towards zero
+	schedule.FloorProvision = math.LegacyZeroDec()
+	require.Equal(t, math.LegacyMustNewDecFromStr("62.5"), types.CalculatePeriodMintProvision(schedule, 4))
+	require.True(t, types.CalculatePeriodMintProvision(schedule, 1000).IsZero())
+
+	// a decay factor of one mints the same provision in every period
+	schedule.DecayFactor = math.LegacyOneDec()
+	require.Equal(t, math.LegacyNewDec(1000), types.CalculatePeriodMintProvision(schedule, 1000))
+}
+
+func TestCalculateMultiplier(t *testing.T) {
+	dynamic := types.DynamicInflation{
+		TargetBondedRatio:  math.LegacyMustNewDecFromStr("0.5"),
+		MinMultiplier:      math.LegacyMustNewDecFromStr("0.5"),
+		MaxMultiplier:      math.LegacyNewDec(2),
+		MaxChangePerPeriod: math.LegacyMustNewDecFromStr("0.4"),
+	}
+	dec := math.LegacyMustNewDecFromStr
+
+	for _, tc := range []struct {
+		name        string
+		target      string
+		multiplier  string
+		bondedRatio string
+		expected    string
+	}{
+		{name: "at the target", multiplier: "1", bondedRatio: "0.5", expected: "1"},
+		{name: "below the target", multiplier: "1", bondedRatio: "0.25", expected: "1.05"},
+		{name: "nothing bonded", multiplier: "1", bondedRatio: "0", expected: "1.1"},
+		{name: "all bonded", multiplier: "1", bondedRatio: "1", expected: "0.9"},
+		{name: "deviation capped to one", target: "0.2", multiplier: "1", bondedRatio: "1", expected: "0.9"},
+		{name: "max multiplier", multiplier: "1.95", bondedRatio: "0", expected: "2"},
+		{name: "min multiplier", multiplier: "0.55", bondedRatio: "1", expected: "0.5"},
+		{name: "below the bounds", multiplier: "0.1", bondedRatio: "0.5", expected: "0.5"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := dynamic
+			if tc.target != "" {
+				d.TargetBondedRatio = dec(tc.target)
+			}
+			require.Equal(t, dec(tc.expected), types.CalculateMultiplier(d, dec(tc.multiplier), dec(tc.bondedRatio), 4))
+		})
+	}
+
+	// without epochs the multiplier is only kept within the bounds
+	require.Equal(t, math.LegacyNewDec(2), types.CalculateMultiplier(dynamic, math.LegacyNewDec(3), math.LegacyZeroDec(), 0))
+}
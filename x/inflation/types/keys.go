@@ -16,6 +16,10 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "inflation"
@@ -31,15 +35,28 @@ const (
 )
 
 var (
-	ParamsKey                = []byte("params_inflation")
-	PeriodKey                = []byte("period_inflation")
-	EpochIdentifierKey       = []byte("epoch_identifier_inflation")
-	EpochsPerPeriodKey       = []byte("epochs_per_period_inflation")
-	SkippedEpochsKey         = []byte("skipped_epochs_inflation")
-	PeriodMintProvisionsKey  = []byte("period_mint_provisions_inflation")
-	InflationDistributionKey = []byte("inflation_distribution_inflation")
+	ParamsKey               = []byte("params_inflation")
+	PeriodKey               = []byte("period_inflation")
+	EpochIdentifierKey      = []byte("epoch_identifier_inflation")
+	EpochsPerPeriodKey      = []byte("epochs_per_period_inflation")
+	SkippedEpochsKey        = []byte("skipped_epochs_inflation")
+	PeriodMintProvisionsKey = []byte("period_mint_provisions_inflation")
+	MaxSupplyReachedKey     = []byte("max_supply_reached_inflation")
+	MultiplierKey           = []byte("multiplier_inflation")
+
+	// KeyPrefixFailedEpoch prefixes the records of failed epochs
+	KeyPrefixFailedEpoch = []byte("failed_epoch_inflation")
+	// KeyPrefixRemainder prefixes the remainder carried to the next epoch by
+	// denom
+	KeyPrefixRemainder = []byte("remainder_inflation")
 )
 
+// FailedEpochKey returns the key of a failed epoch relative to
+// KeyPrefixFailedEpoch, ordered by epoch number
+func FailedEpochKey(epochNumber int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epochNumber))
+}
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
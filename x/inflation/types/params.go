@@ -17,12 +17,30 @@
 package types
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+const (
+	// DefaultPreviewPeriods is the number of periods an emission preview
+	// returns by default
+	DefaultPreviewPeriods uint32 = 10
+	// MaxPreviewPeriods is the maximum number of periods of an emission
+	// preview
+	MaxPreviewPeriods uint32 = 1000
+	// MaxSimulatedEpochs is the maximum number of epochs of an emission
+	// simulation
+	MaxSimulatedEpochs uint32 = 366
+	// SimulateEmissionGasLimit is the gas an emission simulation may consume
+	// running the epoch hook
+	SimulateEmissionGasLimit uint64 = 50_000_000
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -31,13 +49,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
-		EnableInflation: true,
-		MintDenom:       defaultDenom,
-		InflationDistribution: InflationDistribution{
-			// If no other shares are specified, validators get 100% of the inflation
-			ValidatorsShare: math.LegacyMustNewDecFromStr("1.0"),
-			OtherShares:     []*InflationShare{},
-		},
+		EnableInflation:       true,
+		MintDenom:             defaultDenom,
+		InflationDistribution: DefaultInflationDistribution(),
+		MaxSupply:             math.ZeroInt(),
 	}
 }
 
@@ -53,6 +68,62 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	// todo
+	if err := sdk.ValidateDenom(p.MintDenom); err != nil {
+		return fmt.Errorf("invalid mint denom: %w", err)
+	}
+	if err := p.InflationDistribution.Validate(); err != nil {
+		return fmt.Errorf("invalid inflation distribution: %w", err)
+	}
+	if _, ok := RemainderPolicy_name[int32(p.RemainderPolicy)]; !ok {
+		return fmt.Errorf("unknown remainder policy: %d", p.RemainderPolicy)
+	}
+	if p.EmissionSchedule != nil {
+		if err := p.EmissionSchedule.Validate(); err != nil {
+			return fmt.Errorf("invalid emission schedule: %w", err)
+		}
+	}
+	// params stored before the max supply was introduced have a nil max supply
+	if !p.MaxSupply.IsNil() && p.MaxSupply.IsNegative() {
+		return fmt.Errorf("max supply must not be negative: %s", p.MaxSupply)
+	}
+	if p.DynamicInflation != nil {
+		if err := p.DynamicInflation.Validate(); err != nil {
+			return fmt.Errorf("invalid dynamic inflation: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate checks that the schedule mints a positive initial provision that
+// does not grow and a floor that is not negative
+func (s EmissionSchedule) Validate() error {
+	if s.InitialProvision.IsNil() || !s.InitialProvision.IsPositive() {
+		return fmt.Errorf("initial provision must be positive: %s", s.InitialProvision)
+	}
+	if s.DecayFactor.IsNil() || !s.DecayFactor.IsPositive() || s.DecayFactor.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("decay factor must be within (0, 1]: %s", s.DecayFactor)
+	}
+	if s.FloorProvision.IsNil() || s.FloorProvision.IsNegative() {
+		return fmt.Errorf("floor provision must not be negative: %s", s.FloorProvision)
+	}
+	return nil
+}
+
+// Validate checks that the target bonded ratio is within (0, 1], the
+// multiplier bounds are ordered and not negative, and the change of the
+// multiplier is not negative
+func (d DynamicInflation) Validate() error {
+	if d.TargetBondedRatio.IsNil() || !d.TargetBondedRatio.IsPositive() || d.TargetBondedRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("target bonded ratio must be within (0, 1]: %s", d.TargetBondedRatio)
+	}
+	if d.MinMultiplier.IsNil() || d.MinMultiplier.IsNegative() {
+		return fmt.Errorf("min multiplier must not be negative: %s", d.MinMultiplier)
+	}
+	if d.MaxMultiplier.IsNil() || d.MaxMultiplier.LT(d.MinMultiplier) {
+		return fmt.Errorf("max multiplier %s must not be below the min multiplier %s", d.MaxMultiplier, d.MinMultiplier)
+	}
+	if d.MaxChangePerPeriod.IsNil() || d.MaxChangePerPeriod.IsNegative() {
+		return fmt.Errorf("max change per period must not be negative: %s", d.MaxChangePerPeriod)
+	}
 	return nil
 }
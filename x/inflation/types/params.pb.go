@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,6 +26,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RemainderPolicy defines what happens to the coins of an epoch that no share
+// receives, because shares are truncated or sum up to less than 1.
+type RemainderPolicy int32
+
+const (
+	// REMAINDER_POLICY_VALIDATORS sends the remainder to the fee collector.
+	RemainderPolicyValidators RemainderPolicy = 0
+	// REMAINDER_POLICY_CARRY keeps the remainder in the inflation module account
+	// and allocates it together with the coins of the next epoch.
+	RemainderPolicyCarry RemainderPolicy = 1
+	// REMAINDER_POLICY_COMMUNITY_POOL funds the community pool of x/distribution.
+	RemainderPolicyCommunityPool RemainderPolicy = 2
+	// REMAINDER_POLICY_BURN burns the remainder.
+	RemainderPolicyBurn RemainderPolicy = 3
+)
+
+var RemainderPolicy_name = map[int32]string{
+	0: "REMAINDER_POLICY_VALIDATORS",
+	1: "REMAINDER_POLICY_CARRY",
+	2: "REMAINDER_POLICY_COMMUNITY_POOL",
+	3: "REMAINDER_POLICY_BURN",
+}
+
+var RemainderPolicy_value = map[string]int32{
+	"REMAINDER_POLICY_VALIDATORS":     0,
+	"REMAINDER_POLICY_CARRY":          1,
+	"REMAINDER_POLICY_COMMUNITY_POOL": 2,
+	"REMAINDER_POLICY_BURN":           3,
+}
+
+func (x RemainderPolicy) String() string {
+	return proto.EnumName(RemainderPolicy_name, int32(x))
+}
+
+func (RemainderPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_27d47fd4d54cb8b1, []int{0}
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	// mint_denom specifies the type of coin to mint
@@ -32,6 +72,16 @@ type Params struct {
 	InflationDistribution InflationDistribution `protobuf:"bytes,2,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
 	// enable_inflation is the parameter that enables inflation and halts increasing the skipped_epochs
 	EnableInflation bool `protobuf:"varint,3,opt,name=enable_inflation,json=enableInflation,proto3" json:"enable_inflation,omitempty"`
+	// remainder_policy for the coins of an epoch that no share receives
+	RemainderPolicy RemainderPolicy `protobuf:"varint,4,opt,name=remainder_policy,json=remainderPolicy,proto3,enum=galactica.inflation.RemainderPolicy" json:"remainder_policy,omitempty"`
+	// emission_schedule computes the mint provision of each period. If it is
+	// not set, the period_mint_provisions of the genesis are minted.
+	EmissionSchedule *EmissionSchedule `protobuf:"bytes,5,opt,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule,omitempty"`
+	// max_supply of the mint denom the inflation mints up to, zero for no limit
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// dynamic_inflation scales the epoch provision toward a target bonded
+	// ratio. If it is not set, the scheduled provision is minted.
+	DynamicInflation *DynamicInflation `protobuf:"bytes,7,opt,name=dynamic_inflation,json=dynamicInflation,proto3" json:"dynamic_inflation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,32 +138,183 @@ func (m *Params) GetEnableInflation() bool {
 	return false
 }
 
+func (m *Params) GetRemainderPolicy() RemainderPolicy {
+	if m != nil {
+		return m.RemainderPolicy
+	}
+	return RemainderPolicyValidators
+}
+
+func (m *Params) GetEmissionSchedule() *EmissionSchedule {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetDynamicInflation() *DynamicInflation {
+	if m != nil {
+		return m.DynamicInflation
+	}
+	return nil
+}
+
+// EmissionSchedule computes the mint provision of a period as
+// max(initial_provision * decay_factor^period, floor_provision).
+type EmissionSchedule struct {
+	// initial_provision is the mint provision of the first period
+	InitialProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=initial_provision,json=initialProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"initial_provision"`
+	// decay_factor within (0, 1] multiplies the provision of a period to get
+	// the provision of the next period
+	DecayFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=decay_factor,json=decayFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"decay_factor"`
+	// floor_provision is the minimum provision of a period, which keeps a tail
+	// emission once the decayed provision falls below it. Zero for none.
+	FloorProvision cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=floor_provision,json=floorProvision,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"floor_provision"`
+}
+
+func (m *EmissionSchedule) Reset()         { *m = EmissionSchedule{} }
+func (m *EmissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*EmissionSchedule) ProtoMessage()    {}
+func (*EmissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27d47fd4d54cb8b1, []int{1}
+}
+func (m *EmissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionSchedule.Merge(m, src)
+}
+func (m *EmissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionSchedule proto.InternalMessageInfo
+
+// DynamicInflation scales the scheduled epoch provision by a multiplier. In
+// every epoch the multiplier rises while the bonded ratio is below the target
+// and falls while it is above, by at most max_change_per_period over the
+// epochs of a period, and stays within min_multiplier and max_multiplier.
+type DynamicInflation struct {
+	// target_bonded_ratio within (0, 1] the multiplier steers toward
+	TargetBondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=target_bonded_ratio,json=targetBondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_bonded_ratio"`
+	// min_multiplier is the lower bound of the multiplier
+	MinMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_multiplier,json=minMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_multiplier"`
+	// max_multiplier is the upper bound of the multiplier
+	MaxMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_multiplier,json=maxMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_multiplier"`
+	// max_change_per_period is the largest change of the multiplier in a period
+	MaxChangePerPeriod cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_change_per_period,json=maxChangePerPeriod,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_per_period"`
+}
+
+func (m *DynamicInflation) Reset()         { *m = DynamicInflation{} }
+func (m *DynamicInflation) String() string { return proto.CompactTextString(m) }
+func (*DynamicInflation) ProtoMessage()    {}
+func (*DynamicInflation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27d47fd4d54cb8b1, []int{2}
+}
+func (m *DynamicInflation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicInflation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicInflation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicInflation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicInflation.Merge(m, src)
+}
+func (m *DynamicInflation) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicInflation) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicInflation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicInflation proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("galactica.inflation.RemainderPolicy", RemainderPolicy_name, RemainderPolicy_value)
 	proto.RegisterType((*Params)(nil), "galactica.inflation.Params")
+	proto.RegisterType((*EmissionSchedule)(nil), "galactica.inflation.EmissionSchedule")
+	proto.RegisterType((*DynamicInflation)(nil), "galactica.inflation.DynamicInflation")
 }
 
 func init() { proto.RegisterFile("galactica/inflation/params.proto", fileDescriptor_27d47fd4d54cb8b1) }
 
 var fileDescriptor_27d47fd4d54cb8b1 = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4f, 0xcc, 0x49,
-	0x4c, 0x2e, 0xc9, 0x4c, 0x4e, 0xd4, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xab,
-	0xd0, 0x83, 0xab, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x75, 0x52,
-	0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x55, 0xc6, 0x66, 0x3e, 0x9c,
-	0x05, 0x51, 0xa4, 0xf4, 0x88, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xa7, 0x90, 0x2c, 0x17, 0x57, 0x6e,
-	0x66, 0x5e, 0x49, 0x7c, 0x4a, 0x6a, 0x5e, 0x7e, 0xae, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10,
-	0x27, 0x48, 0xc4, 0x05, 0x24, 0x20, 0x94, 0xce, 0x25, 0x06, 0xd7, 0x1c, 0x9f, 0x92, 0x59, 0x5c,
-	0x52, 0x94, 0x99, 0x54, 0x0a, 0xe2, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0x69, 0xe9, 0x61,
-	0x71, 0xad, 0x9e, 0x27, 0x8c, 0xe5, 0x82, 0xa4, 0xc3, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20,
-	0xd1, 0x4c, 0x6c, 0x92, 0x42, 0x9a, 0x5c, 0x02, 0xa9, 0x79, 0x89, 0x49, 0x39, 0xa9, 0xf1, 0x70,
-	0x79, 0x09, 0x66, 0x05, 0x46, 0x0d, 0x8e, 0x20, 0x7e, 0x88, 0x38, 0xdc, 0x4c, 0x2b, 0xd5, 0x17,
-	0x0b, 0xe4, 0x19, 0xbb, 0x9e, 0x6f, 0xd0, 0x92, 0x41, 0xf8, 0xb5, 0x02, 0xc9, 0xb7, 0x10, 0x9f,
-	0x39, 0xf9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x69, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0xbe, 0x3b, 0xcc, 0x08, 0xdd, 0xe4, 0xfc, 0xa2,
-	0x02, 0x7d, 0xec, 0x26, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xcf, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0x7d, 0xd9, 0xf5, 0x24, 0xc3, 0x01, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x24, 0x14, 0x3a, 0x0b, 0xad, 0x3b, 0xdd, 0x2e, 0xde, 0xec, 0x6e, 0x6a, 0x15,
+	0x56, 0x2a, 0x45, 0x9b, 0x88, 0x02, 0x97, 0x3d, 0x20, 0xe5, 0x17, 0x28, 0x28, 0x69, 0xac, 0x69,
+	0x77, 0x45, 0xf7, 0x80, 0x35, 0xb1, 0xa7, 0xce, 0x08, 0xcf, 0x8c, 0x35, 0x9e, 0xa0, 0xf8, 0x3f,
+	0x58, 0xe5, 0xc4, 0x85, 0x63, 0x24, 0x24, 0x0e, 0x48, 0x9c, 0x38, 0xf0, 0x47, 0xec, 0x71, 0xc5,
+	0x09, 0x71, 0x58, 0xa1, 0xf6, 0x00, 0x7f, 0x06, 0xb2, 0x9d, 0x98, 0xe0, 0x44, 0x1c, 0xb2, 0x97,
+	0x6a, 0xe6, 0xcd, 0xf7, 0xfb, 0xf1, 0x7b, 0x6f, 0x5e, 0x27, 0xc0, 0xf4, 0xb0, 0x8f, 0x1d, 0x45,
+	0x1d, 0x5c, 0xa7, 0xfc, 0xca, 0xc7, 0x8a, 0x0a, 0x5e, 0x0f, 0xb0, 0xc4, 0x2c, 0xac, 0x05, 0x52,
+	0x28, 0x01, 0xf7, 0x33, 0x45, 0x2d, 0x53, 0x54, 0xf6, 0x30, 0xa3, 0x5c, 0xd4, 0x93, 0xbf, 0xa9,
+	0xae, 0x72, 0xd7, 0x11, 0x21, 0x13, 0xa1, 0x9d, 0xec, 0xea, 0xe9, 0x66, 0x7e, 0x74, 0xdb, 0x13,
+	0x9e, 0x48, 0xe3, 0xf1, 0x6a, 0x1e, 0x7d, 0x6f, 0xdd, 0xa7, 0xb3, 0x55, 0x2a, 0x3a, 0xfa, 0xa9,
+	0x0c, 0xb6, 0xac, 0x24, 0x1d, 0xf8, 0x00, 0x00, 0x46, 0xb9, 0xb2, 0x5d, 0xc2, 0x05, 0x33, 0x34,
+	0x53, 0x3b, 0xde, 0x46, 0xdb, 0x71, 0xa4, 0x1d, 0x07, 0xa0, 0x07, 0xee, 0x64, 0x66, 0xdb, 0xa5,
+	0xa1, 0x92, 0x74, 0x38, 0x8e, 0x37, 0x46, 0xd1, 0xd4, 0x8e, 0x6f, 0x9d, 0x9e, 0xd4, 0xd6, 0x14,
+	0x52, 0xeb, 0x2e, 0x56, 0xed, 0x25, 0x47, 0xb3, 0xfc, 0xe2, 0xd5, 0x61, 0x01, 0x1d, 0xd0, 0x75,
+	0x87, 0xf0, 0x03, 0xa0, 0x13, 0x8e, 0x87, 0x3e, 0xb1, 0xb3, 0x73, 0xa3, 0x64, 0x6a, 0xc7, 0x6f,
+	0xa1, 0xdd, 0x34, 0x9e, 0x31, 0xe1, 0x00, 0xe8, 0x92, 0x30, 0x4c, 0xb9, 0x4b, 0xa4, 0x1d, 0x08,
+	0x9f, 0x3a, 0x91, 0x51, 0x36, 0xb5, 0xe3, 0x9d, 0xd3, 0xf7, 0xd7, 0x66, 0x83, 0x16, 0x62, 0x2b,
+	0xd1, 0xa2, 0x5d, 0xf9, 0xdf, 0x00, 0x44, 0x60, 0x8f, 0x30, 0x1a, 0x86, 0x71, 0x8d, 0xa1, 0x33,
+	0x22, 0xee, 0xd8, 0x27, 0xc6, 0x1b, 0x49, 0x7d, 0x0f, 0xd7, 0x12, 0x3b, 0x73, 0xf5, 0xf9, 0x5c,
+	0x8c, 0x74, 0x92, 0x8b, 0xc0, 0x2f, 0x01, 0x60, 0x78, 0x62, 0x87, 0xe3, 0x20, 0xf0, 0x23, 0x63,
+	0x2b, 0xee, 0x6b, 0xf3, 0xc3, 0xb8, 0x01, 0x7f, 0xbc, 0x3a, 0x3c, 0x48, 0xef, 0x31, 0x74, 0xbf,
+	0xa9, 0x51, 0x51, 0x67, 0x58, 0x8d, 0x6a, 0x5d, 0xae, 0x7e, 0xfb, 0xf5, 0x11, 0x98, 0x5f, 0x70,
+	0x97, 0x2b, 0xb4, 0xcd, 0xf0, 0xe4, 0x3c, 0x71, 0xc7, 0xf9, 0xb9, 0x11, 0xc7, 0x8c, 0x3a, 0x4b,
+	0xcd, 0x79, 0xf3, 0x7f, 0xf2, 0x6b, 0xa7, 0xea, 0xac, 0x65, 0x48, 0x77, 0x73, 0x91, 0xc7, 0x0f,
+	0xff, 0xfe, 0xe1, 0x50, 0x9b, 0xfe, 0xf5, 0xcb, 0xc9, 0xfd, 0x7f, 0x07, 0x66, 0xb2, 0x34, 0x32,
+	0xe9, 0x78, 0x1c, 0xfd, 0x5c, 0x04, 0x7a, 0xbe, 0x5a, 0xf8, 0x35, 0xd8, 0xa3, 0x9c, 0x2a, 0x8a,
+	0xfd, 0x78, 0x2e, 0xbf, 0xa5, 0xf1, 0x61, 0x3a, 0x3a, 0xcd, 0x8f, 0xe6, 0x25, 0xde, 0x5b, 0x2d,
+	0xb1, 0x47, 0x3c, 0xec, 0x44, 0x6d, 0xe2, 0x2c, 0x15, 0xda, 0x26, 0x0e, 0xd2, 0xe7, 0x2c, 0x6b,
+	0x81, 0x82, 0x17, 0xe0, 0x6d, 0x97, 0x38, 0x38, 0xb2, 0xaf, 0xb0, 0xa3, 0x84, 0x34, 0x8a, 0x9b,
+	0xa2, 0x6f, 0x25, 0x98, 0xcf, 0x13, 0x0a, 0x7c, 0x06, 0x76, 0xaf, 0x7c, 0x21, 0xe4, 0x52, 0xce,
+	0xa5, 0x4d, 0xc1, 0x3b, 0x09, 0x29, 0xcb, 0xf8, 0x71, 0x39, 0xee, 0xe6, 0xd1, 0xf7, 0x25, 0xa0,
+	0xe7, 0x5b, 0x0f, 0x31, 0xd8, 0x57, 0x58, 0x7a, 0x44, 0xd9, 0x43, 0xc1, 0x5d, 0xe2, 0xda, 0x32,
+	0x8e, 0x6f, 0xde, 0xae, 0xbd, 0x94, 0xd6, 0x4c, 0x60, 0x28, 0x66, 0xc1, 0xaf, 0xc0, 0x0e, 0xa3,
+	0xdc, 0x66, 0x63, 0x5f, 0xd1, 0xc0, 0xa7, 0xe4, 0x35, 0x3a, 0xf6, 0x0e, 0xa3, 0xbc, 0x9f, 0x71,
+	0x12, 0x32, 0x9e, 0x2c, 0x93, 0x4b, 0x9b, 0x93, 0xf1, 0x64, 0x89, 0xec, 0x82, 0x83, 0x98, 0xec,
+	0x8c, 0x30, 0xf7, 0x88, 0x1d, 0xc4, 0xff, 0xc9, 0x44, 0x52, 0xe1, 0x1a, 0xe5, 0x4d, 0x3f, 0x00,
+	0x19, 0x9e, 0xb4, 0x12, 0x9c, 0x45, 0xa4, 0x95, 0xc0, 0xd2, 0x7b, 0x39, 0x79, 0x5e, 0x04, 0xbb,
+	0xb9, 0x47, 0x00, 0x7e, 0x06, 0xee, 0xa1, 0x4e, 0xbf, 0xd1, 0x3d, 0x6b, 0x77, 0x90, 0x6d, 0x0d,
+	0x7a, 0xdd, 0xd6, 0xa5, 0xfd, 0xb4, 0xd1, 0xeb, 0xb6, 0x1b, 0x17, 0x03, 0x74, 0xae, 0x17, 0x2a,
+	0x0f, 0xa6, 0x33, 0xf3, 0x6e, 0xce, 0xf5, 0x14, 0xfb, 0xd4, 0xc5, 0x4a, 0xc8, 0x10, 0x7e, 0x02,
+	0xee, 0xac, 0xf8, 0x5b, 0x0d, 0x84, 0x2e, 0x75, 0xad, 0x62, 0x4c, 0x67, 0xe6, 0xed, 0x9c, 0xb5,
+	0x85, 0xa5, 0x8c, 0x60, 0x07, 0x1c, 0xae, 0xba, 0x06, 0xfd, 0xfe, 0x93, 0xb3, 0xee, 0xc5, 0xa5,
+	0x6d, 0x0d, 0x06, 0x3d, 0xbd, 0x58, 0x31, 0xa7, 0x33, 0xf3, 0x7e, 0xde, 0x2e, 0x18, 0x1b, 0x73,
+	0xaa, 0x22, 0x4b, 0x08, 0x1f, 0x9e, 0x82, 0x83, 0x15, 0x4c, 0xf3, 0x09, 0x3a, 0xd3, 0x4b, 0x95,
+	0x77, 0xa7, 0x33, 0x73, 0x3f, 0x67, 0x6e, 0x8e, 0x25, 0xaf, 0x94, 0x9f, 0xff, 0x58, 0x2d, 0x34,
+	0x07, 0x2f, 0xae, 0xab, 0xda, 0xcb, 0xeb, 0xaa, 0xf6, 0xe7, 0x75, 0x55, 0xfb, 0xee, 0xa6, 0x5a,
+	0x78, 0x79, 0x53, 0x2d, 0xfc, 0x7e, 0x53, 0x2d, 0x3c, 0xfb, 0xd4, 0xa3, 0x6a, 0x34, 0x1e, 0xd6,
+	0x1c, 0xc1, 0xea, 0x5f, 0x2c, 0x9e, 0x84, 0x47, 0x8e, 0x90, 0x41, 0x7d, 0xfd, 0x0b, 0xa1, 0xa2,
+	0x80, 0x84, 0xc3, 0xad, 0xe4, 0x17, 0xe5, 0xe3, 0x7f, 0x06, 0x00, 0x47, 0xf1, 0x57, 0x47, 0xf3,
+	0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -144,6 +345,81 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.EnableInflation != that1.EnableInflation {
 		return false
 	}
+	if this.RemainderPolicy != that1.RemainderPolicy {
+		return false
+	}
+	if !this.EmissionSchedule.Equal(that1.EmissionSchedule) {
+		return false
+	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if !this.DynamicInflation.Equal(that1.DynamicInflation) {
+		return false
+	}
+	return true
+}
+func (this *EmissionSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmissionSchedule)
+	if !ok {
+		that2, ok := that.(EmissionSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.InitialProvision.Equal(that1.InitialProvision) {
+		return false
+	}
+	if !this.DecayFactor.Equal(that1.DecayFactor) {
+		return false
+	}
+	if !this.FloorProvision.Equal(that1.FloorProvision) {
+		return false
+	}
+	return true
+}
+func (this *DynamicInflation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DynamicInflation)
+	if !ok {
+		that2, ok := that.(DynamicInflation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.TargetBondedRatio.Equal(that1.TargetBondedRatio) {
+		return false
+	}
+	if !this.MinMultiplier.Equal(that1.MinMultiplier) {
+		return false
+	}
+	if !this.MaxMultiplier.Equal(that1.MaxMultiplier) {
+		return false
+	}
+	if !this.MaxChangePerPeriod.Equal(that1.MaxChangePerPeriod) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -166,6 +442,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DynamicInflation != nil {
+		{
+			size, err := m.DynamicInflation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.EmissionSchedule != nil {
+		{
+			size, err := m.EmissionSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RemainderPolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RemainderPolicy))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.EnableInflation {
 		i--
 		if m.EnableInflation {
@@ -196,6 +511,122 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EmissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FloorProvision.Size()
+		i -= size
+		if _, err := m.FloorProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialProvision.Size()
+		i -= size
+		if _, err := m.InitialProvision.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicInflation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicInflation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangePerPeriod.Size()
+		i -= size
+		if _, err := m.MaxChangePerPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxMultiplier.Size()
+		i -= size
+		if _, err := m.MaxMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinMultiplier.Size()
+		i -= size
+		if _, err := m.MinMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TargetBondedRatio.Size()
+		i -= size
+		if _, err := m.TargetBondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -222,14 +653,59 @@ func (m *Params) Size() (n int) {
 	if m.EnableInflation {
 		n += 2
 	}
+	if m.RemainderPolicy != 0 {
+		n += 1 + sovParams(uint64(m.RemainderPolicy))
+	}
+	if m.EmissionSchedule != nil {
+		l = m.EmissionSchedule.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicInflation != nil {
+		l = m.DynamicInflation.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EmissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialProvision.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.FloorProvision.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *DynamicInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TargetBondedRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxMultiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxChangePerPeriod.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -345,6 +821,469 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableInflation = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainderPolicy", wireType)
+			}
+			m.RemainderPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainderPolicy |= RemainderPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionSchedule == nil {
+				m.EmissionSchedule = &EmissionSchedule{}
+			}
+			if err := m.EmissionSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicInflation == nil {
+				m.DynamicInflation = &DynamicInflation{}
+			}
+			if err := m.DynamicInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorProvision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicInflation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicInflation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetBondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,8 +5,12 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,214 +118,7101 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "galactica.inflation.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "galactica.inflation.QueryParamsResponse")
+// QueryPeriodRequest is the request type for the Query/Period RPC method.
+type QueryPeriodRequest struct {
 }
 
-func init() { proto.RegisterFile("galactica/inflation/query.proto", fileDescriptor_ae5baee6426d48ab) }
-
-var fileDescriptor_ae5baee6426d48ab = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4f, 0xcc, 0x49,
-	0x4c, 0x2e, 0xc9, 0x4c, 0x4e, 0xd4, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x2b, 0xd0,
-	0x83, 0x2b, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x75, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0x49, 0xcf, 0xcf, 0x4f, 0xcf,
-	0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x01, 0xeb, 0x2f, 0x86, 0xca,
-	0x6a, 0x25, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x27, 0x25, 0x16, 0xa7, 0x42, 0x2c, 0xd5, 0x2f,
-	0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x4c, 0xcf, 0xcc, 0x03, 0x2b, 0x86, 0xaa,
-	0x55, 0xc0, 0xe6, 0xd0, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x69, 0x4a, 0x22, 0x5c, 0x42, 0x81,
-	0x20, 0x33, 0x02, 0xc0, 0x82, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x4a, 0xa1, 0x5c, 0xc2,
-	0x28, 0xa2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x76, 0x5c, 0x6c, 0x10, 0xcd, 0x12, 0x8c,
-	0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xd2, 0x7a, 0x58, 0xfc, 0xa9, 0x07, 0xd1, 0xe4, 0xc4, 0x79, 0xe2,
-	0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0xba, 0x8c, 0x66, 0x32, 0x72, 0xb1,
-	0x82, 0xcd, 0x15, 0xea, 0x67, 0xe4, 0x62, 0x83, 0xa8, 0x13, 0x52, 0xc7, 0x6a, 0x08, 0xa6, 0xa3,
-	0xa4, 0x34, 0x08, 0x2b, 0x84, 0xb8, 0x53, 0xc9, 0xa8, 0xe9, 0xf2, 0x93, 0xc9, 0x4c, 0x3a, 0x42,
-	0x5a, 0xfa, 0xee, 0x30, 0x1d, 0xba, 0xc9, 0xf9, 0x45, 0x05, 0xfa, 0xb8, 0x83, 0xc3, 0xc9, 0xff,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0x71, 0x9b, 0x57, 0x81, 0x64, 0x62, 0x49, 0x65, 0x41, 0x6a, 0x71,
-	0x12, 0x1b, 0x38, 0x80, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x22, 0x16, 0xd6, 0x73, 0x2d,
-	0x02, 0x00, 0x00,
+func (m *QueryPeriodRequest) Reset()         { *m = QueryPeriodRequest{} }
+func (m *QueryPeriodRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodRequest) ProtoMessage()    {}
+func (*QueryPeriodRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{2}
+}
+func (m *QueryPeriodRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodRequest.Merge(m, src)
+}
+func (m *QueryPeriodRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryPeriodRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryPeriodResponse is the response type for the Query/Period RPC method.
+type QueryPeriodResponse struct {
+	// period is the current inflation period, starting at zero
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryPeriodResponse) Reset()         { *m = QueryPeriodResponse{} }
+func (m *QueryPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodResponse) ProtoMessage()    {}
+func (*QueryPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{3}
+}
+func (m *QueryPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodResponse.Merge(m, src)
+}
+func (m *QueryPeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryPeriodResponse proto.InternalMessageInfo
+
+func (m *QueryPeriodResponse) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryEpochsPerPeriodRequest is the request type for the
+// Query/EpochsPerPeriod RPC method.
+type QueryEpochsPerPeriodRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEpochsPerPeriodRequest) Reset()         { *m = QueryEpochsPerPeriodRequest{} }
+func (m *QueryEpochsPerPeriodRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochsPerPeriodRequest) ProtoMessage()    {}
+func (*QueryEpochsPerPeriodRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{4}
+}
+func (m *QueryEpochsPerPeriodRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochsPerPeriodRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochsPerPeriodRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEpochsPerPeriodRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochsPerPeriodRequest.Merge(m, src)
+}
+func (m *QueryEpochsPerPeriodRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochsPerPeriodRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochsPerPeriodRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryEpochsPerPeriodRequest proto.InternalMessageInfo
+
+// QueryEpochsPerPeriodResponse is the response type for the
+// Query/EpochsPerPeriod RPC method.
+type QueryEpochsPerPeriodResponse struct {
+	// epochs_per_period is the number of epochs of an inflation period
+	EpochsPerPeriod int64 `protobuf:"varint,1,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryEpochsPerPeriodResponse) Reset()         { *m = QueryEpochsPerPeriodResponse{} }
+func (m *QueryEpochsPerPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochsPerPeriodResponse) ProtoMessage()    {}
+func (*QueryEpochsPerPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{5}
+}
+func (m *QueryEpochsPerPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochsPerPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochsPerPeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochsPerPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochsPerPeriodResponse.Merge(m, src)
+}
+func (m *QueryEpochsPerPeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochsPerPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochsPerPeriodResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryEpochsPerPeriodResponse proto.InternalMessageInfo
+
+func (m *QueryEpochsPerPeriodResponse) GetEpochsPerPeriod() int64 {
+	if m != nil {
+		return m.EpochsPerPeriod
+	}
+	return 0
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QuerySkippedEpochsRequest is the request type for the Query/SkippedEpochs
+// RPC method.
+type QuerySkippedEpochsRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+func (m *QuerySkippedEpochsRequest) Reset()         { *m = QuerySkippedEpochsRequest{} }
+func (m *QuerySkippedEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedEpochsRequest) ProtoMessage()    {}
+func (*QuerySkippedEpochsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{6}
+}
+func (m *QuerySkippedEpochsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkippedEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedEpochsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galactica.inflation.Query/Params",
+}
+func (m *QuerySkippedEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedEpochsRequest.Merge(m, src)
+}
+func (m *QuerySkippedEpochsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedEpochsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkippedEpochsRequest proto.InternalMessageInfo
+
+// QuerySkippedEpochsResponse is the response type for the
+// Query/SkippedEpochs RPC method.
+type QuerySkippedEpochsResponse struct {
+	// skipped_epochs is the number of epochs without inflation
+	SkippedEpochs uint64 `protobuf:"varint,1,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+}
+
+func (m *QuerySkippedEpochsResponse) Reset()         { *m = QuerySkippedEpochsResponse{} }
+func (m *QuerySkippedEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySkippedEpochsResponse) ProtoMessage()    {}
+func (*QuerySkippedEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{7}
+}
+func (m *QuerySkippedEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySkippedEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySkippedEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QuerySkippedEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySkippedEpochsResponse.Merge(m, src)
+}
+func (m *QuerySkippedEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySkippedEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySkippedEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySkippedEpochsResponse proto.InternalMessageInfo
+
+func (m *QuerySkippedEpochsResponse) GetSkippedEpochs() uint64 {
+	if m != nil {
+		return m.SkippedEpochs
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "galactica.inflation.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "galactica/inflation/query.proto",
+// QueryEpochIdentifierRequest is the request type for the
+// Query/EpochIdentifier RPC method.
+type QueryEpochIdentifierRequest struct {
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEpochIdentifierRequest) Reset()         { *m = QueryEpochIdentifierRequest{} }
+func (m *QueryEpochIdentifierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochIdentifierRequest) ProtoMessage()    {}
+func (*QueryEpochIdentifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{8}
+}
+func (m *QueryEpochIdentifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochIdentifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryEpochIdentifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochIdentifierRequest.Merge(m, src)
+}
+func (m *QueryEpochIdentifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochIdentifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochIdentifierRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEpochIdentifierRequest proto.InternalMessageInfo
+
+// QueryEpochIdentifierResponse is the response type for the
+// Query/EpochIdentifier RPC method.
+type QueryEpochIdentifierResponse struct {
+	// epoch_identifier of the epochs inflation is minted for
+	EpochIdentifier string `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryEpochIdentifierResponse) Reset()         { *m = QueryEpochIdentifierResponse{} }
+func (m *QueryEpochIdentifierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochIdentifierResponse) ProtoMessage()    {}
+func (*QueryEpochIdentifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{9}
+}
+func (m *QueryEpochIdentifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochIdentifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochIdentifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochIdentifierResponse.Merge(m, src)
+}
+func (m *QueryEpochIdentifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochIdentifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochIdentifierResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEpochIdentifierResponse proto.InternalMessageInfo
+
+func (m *QueryEpochIdentifierResponse) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPeriodMintProvisionsRequest is the request type for the
+// Query/PeriodMintProvisions RPC method.
+type QueryPeriodMintProvisionsRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPeriodMintProvisionsRequest) Reset()         { *m = QueryPeriodMintProvisionsRequest{} }
+func (m *QueryPeriodMintProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodMintProvisionsRequest) ProtoMessage()    {}
+func (*QueryPeriodMintProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{10}
+}
+func (m *QueryPeriodMintProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodMintProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodMintProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodMintProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodMintProvisionsRequest.Merge(m, src)
+}
+func (m *QueryPeriodMintProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodMintProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodMintProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodMintProvisionsRequest proto.InternalMessageInfo
+
+// QueryPeriodMintProvisionsResponse is the response type for the
+// Query/PeriodMintProvisions RPC method.
+type QueryPeriodMintProvisionsResponse struct {
+	// period_mint_provisions are the coins minted in each inflation period
+	PeriodMintProvisions github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=period_mint_provisions,json=periodMintProvisions,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"period_mint_provisions"`
+}
+
+func (m *QueryPeriodMintProvisionsResponse) Reset()         { *m = QueryPeriodMintProvisionsResponse{} }
+func (m *QueryPeriodMintProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodMintProvisionsResponse) ProtoMessage()    {}
+func (*QueryPeriodMintProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{11}
+}
+func (m *QueryPeriodMintProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodMintProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodMintProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodMintProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodMintProvisionsResponse.Merge(m, src)
+}
+func (m *QueryPeriodMintProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodMintProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodMintProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodMintProvisionsResponse proto.InternalMessageInfo
+
+func (m *QueryPeriodMintProvisionsResponse) GetPeriodMintProvisions() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PeriodMintProvisions
+	}
+	return nil
+}
+
+// QueryInflationDistributionRequest is the request type for the
+// Query/InflationDistribution RPC method.
+type QueryInflationDistributionRequest struct {
+}
+
+func (m *QueryInflationDistributionRequest) Reset()         { *m = QueryInflationDistributionRequest{} }
+func (m *QueryInflationDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationDistributionRequest) ProtoMessage()    {}
+func (*QueryInflationDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{12}
+}
+func (m *QueryInflationDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationDistributionRequest.Merge(m, src)
+}
+func (m *QueryInflationDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationDistributionRequest proto.InternalMessageInfo
+
+// QueryInflationDistributionResponse is the response type for the
+// Query/InflationDistribution RPC method.
+type QueryInflationDistributionResponse struct {
+	// inflation_distribution of the minted coins
+	InflationDistribution InflationDistribution `protobuf:"bytes,1,opt,name=inflation_distribution,json=inflationDistribution,proto3" json:"inflation_distribution"`
+}
+
+func (m *QueryInflationDistributionResponse) Reset()         { *m = QueryInflationDistributionResponse{} }
+func (m *QueryInflationDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationDistributionResponse) ProtoMessage()    {}
+func (*QueryInflationDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{13}
+}
+func (m *QueryInflationDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationDistributionResponse.Merge(m, src)
+}
+func (m *QueryInflationDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryInflationDistributionResponse) GetInflationDistribution() InflationDistribution {
+	if m != nil {
+		return m.InflationDistribution
+	}
+	return InflationDistribution{}
+}
+
+// QueryEpochMintProvisionRequest is the request type for the
+// Query/EpochMintProvision RPC method.
+type QueryEpochMintProvisionRequest struct {
+}
+
+func (m *QueryEpochMintProvisionRequest) Reset()         { *m = QueryEpochMintProvisionRequest{} }
+func (m *QueryEpochMintProvisionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochMintProvisionRequest) ProtoMessage()    {}
+func (*QueryEpochMintProvisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{14}
+}
+func (m *QueryEpochMintProvisionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochMintProvisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochMintProvisionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochMintProvisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochMintProvisionRequest.Merge(m, src)
+}
+func (m *QueryEpochMintProvisionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochMintProvisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochMintProvisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochMintProvisionRequest proto.InternalMessageInfo
+
+// QueryEpochMintProvisionResponse is the response type for the
+// Query/EpochMintProvision RPC method.
+type QueryEpochMintProvisionResponse struct {
+	// epoch_mint_provision are the coins minted in an epoch of the current
+	// period
+	EpochMintProvision types.DecCoin `protobuf:"bytes,1,opt,name=epoch_mint_provision,json=epochMintProvision,proto3" json:"epoch_mint_provision"`
+}
+
+func (m *QueryEpochMintProvisionResponse) Reset()         { *m = QueryEpochMintProvisionResponse{} }
+func (m *QueryEpochMintProvisionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEpochMintProvisionResponse) ProtoMessage()    {}
+func (*QueryEpochMintProvisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{15}
+}
+func (m *QueryEpochMintProvisionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEpochMintProvisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEpochMintProvisionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEpochMintProvisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEpochMintProvisionResponse.Merge(m, src)
+}
+func (m *QueryEpochMintProvisionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEpochMintProvisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEpochMintProvisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEpochMintProvisionResponse proto.InternalMessageInfo
+
+func (m *QueryEpochMintProvisionResponse) GetEpochMintProvision() types.DecCoin {
+	if m != nil {
+		return m.EpochMintProvision
+	}
+	return types.DecCoin{}
+}
+
+// QueryInflationRateRequest is the request type for the Query/InflationRate
+// RPC method.
+type QueryInflationRateRequest struct {
+}
+
+func (m *QueryInflationRateRequest) Reset()         { *m = QueryInflationRateRequest{} }
+func (m *QueryInflationRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationRateRequest) ProtoMessage()    {}
+func (*QueryInflationRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{16}
+}
+func (m *QueryInflationRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationRateRequest.Merge(m, src)
+}
+func (m *QueryInflationRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationRateRequest proto.InternalMessageInfo
+
+// QueryInflationRateResponse is the response type for the
+// Query/InflationRate RPC method.
+type QueryInflationRateResponse struct {
+	// inflation_rate is the annualized inflation rate in percent of the total
+	// supply of the mint denom
+	InflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=inflation_rate,json=inflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"inflation_rate"`
+}
+
+func (m *QueryInflationRateResponse) Reset()         { *m = QueryInflationRateResponse{} }
+func (m *QueryInflationRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInflationRateResponse) ProtoMessage()    {}
+func (*QueryInflationRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{17}
+}
+func (m *QueryInflationRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInflationRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInflationRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInflationRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInflationRateResponse.Merge(m, src)
+}
+func (m *QueryInflationRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInflationRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInflationRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInflationRateResponse proto.InternalMessageInfo
+
+// QueryCirculatingSupplyRequest is the request type for the
+// Query/CirculatingSupply RPC method.
+type QueryCirculatingSupplyRequest struct {
+}
+
+func (m *QueryCirculatingSupplyRequest) Reset()         { *m = QueryCirculatingSupplyRequest{} }
+func (m *QueryCirculatingSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyRequest) ProtoMessage()    {}
+func (*QueryCirculatingSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{18}
+}
+func (m *QueryCirculatingSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCirculatingSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyRequest.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCirculatingSupplyRequest proto.InternalMessageInfo
+
+// QueryCirculatingSupplyResponse is the response type for the
+// Query/CirculatingSupply RPC method.
+type QueryCirculatingSupplyResponse struct {
+	// circulating_supply of the mint denom
+	CirculatingSupply types.DecCoin `protobuf:"bytes,1,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply"`
+}
+
+func (m *QueryCirculatingSupplyResponse) Reset()         { *m = QueryCirculatingSupplyResponse{} }
+func (m *QueryCirculatingSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCirculatingSupplyResponse) ProtoMessage()    {}
+func (*QueryCirculatingSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{19}
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCirculatingSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCirculatingSupplyResponse.Merge(m, src)
+}
+func (m *QueryCirculatingSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCirculatingSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCirculatingSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCirculatingSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryCirculatingSupplyResponse) GetCirculatingSupply() types.DecCoin {
+	if m != nil {
+		return m.CirculatingSupply
+	}
+	return types.DecCoin{}
+}
+
+// QueryFailedEpochsRequest is the request type for the Query/FailedEpochs RPC
+// method.
+type QueryFailedEpochsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedEpochsRequest) Reset()         { *m = QueryFailedEpochsRequest{} }
+func (m *QueryFailedEpochsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedEpochsRequest) ProtoMessage()    {}
+func (*QueryFailedEpochsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{20}
+}
+func (m *QueryFailedEpochsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedEpochsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedEpochsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedEpochsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedEpochsRequest.Merge(m, src)
+}
+func (m *QueryFailedEpochsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedEpochsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedEpochsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedEpochsRequest proto.InternalMessageInfo
+
+func (m *QueryFailedEpochsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFailedEpochsResponse is the response type for the Query/FailedEpochs
+// RPC method.
+type QueryFailedEpochsResponse struct {
+	// failed_epochs in ascending order of their epoch number
+	FailedEpochs []FailedEpoch       `protobuf:"bytes,1,rep,name=failed_epochs,json=failedEpochs,proto3" json:"failed_epochs"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFailedEpochsResponse) Reset()         { *m = QueryFailedEpochsResponse{} }
+func (m *QueryFailedEpochsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedEpochsResponse) ProtoMessage()    {}
+func (*QueryFailedEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{21}
+}
+func (m *QueryFailedEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFailedEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedEpochsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFailedEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedEpochsResponse.Merge(m, src)
+}
+func (m *QueryFailedEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFailedEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFailedEpochsResponse proto.InternalMessageInfo
+
+func (m *QueryFailedEpochsResponse) GetFailedEpochs() []FailedEpoch {
+	if m != nil {
+		return m.FailedEpochs
+	}
+	return nil
+}
+
+func (m *QueryFailedEpochsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRemainderRequest is the request type for the Query/Remainder RPC
+// method.
+type QueryRemainderRequest struct {
+}
+
+func (m *QueryRemainderRequest) Reset()         { *m = QueryRemainderRequest{} }
+func (m *QueryRemainderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainderRequest) ProtoMessage()    {}
+func (*QueryRemainderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{22}
+}
+func (m *QueryRemainderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainderRequest.Merge(m, src)
+}
+func (m *QueryRemainderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainderRequest proto.InternalMessageInfo
+
+// QueryRemainderResponse is the response type for the Query/Remainder RPC
+// method.
+type QueryRemainderResponse struct {
+	// remainder carried to the next epoch
+	Remainder github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remainder"`
+	// remainder_policy for the coins of an epoch that no share receives
+	RemainderPolicy RemainderPolicy `protobuf:"varint,2,opt,name=remainder_policy,json=remainderPolicy,proto3,enum=galactica.inflation.RemainderPolicy" json:"remainder_policy,omitempty"`
+}
+
+func (m *QueryRemainderResponse) Reset()         { *m = QueryRemainderResponse{} }
+func (m *QueryRemainderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainderResponse) ProtoMessage()    {}
+func (*QueryRemainderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{23}
+}
+func (m *QueryRemainderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRemainderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainderResponse.Merge(m, src)
+}
+func (m *QueryRemainderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRemainderResponse proto.InternalMessageInfo
+
+func (m *QueryRemainderResponse) GetRemainder() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remainder
+	}
+	return nil
+}
+
+func (m *QueryRemainderResponse) GetRemainderPolicy() RemainderPolicy {
+	if m != nil {
+		return m.RemainderPolicy
+	}
+	return RemainderPolicyValidators
+}
+
+// QueryEmissionPreviewRequest is the request type for the
+// Query/EmissionPreview RPC method.
+type QueryEmissionPreviewRequest struct {
+	// periods to preview starting at the current period, 10 if zero
+	Periods uint32 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *QueryEmissionPreviewRequest) Reset()         { *m = QueryEmissionPreviewRequest{} }
+func (m *QueryEmissionPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionPreviewRequest) ProtoMessage()    {}
+func (*QueryEmissionPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{24}
+}
+func (m *QueryEmissionPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionPreviewRequest.Merge(m, src)
+}
+func (m *QueryEmissionPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionPreviewRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionPreviewRequest) GetPeriods() uint32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// QueryEmissionPreviewResponse is the response type for the
+// Query/EmissionPreview RPC method.
+type QueryEmissionPreviewResponse struct {
+	// provisions of the previewed periods
+	Provisions []PeriodProvision `protobuf:"bytes,1,rep,name=provisions,proto3" json:"provisions"`
+}
+
+func (m *QueryEmissionPreviewResponse) Reset()         { *m = QueryEmissionPreviewResponse{} }
+func (m *QueryEmissionPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionPreviewResponse) ProtoMessage()    {}
+func (*QueryEmissionPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{25}
+}
+func (m *QueryEmissionPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionPreviewResponse.Merge(m, src)
+}
+func (m *QueryEmissionPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionPreviewResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionPreviewResponse) GetProvisions() []PeriodProvision {
+	if m != nil {
+		return m.Provisions
+	}
+	return nil
+}
+
+// PeriodProvision is the mint provision of a period
+type PeriodProvision struct {
+	// period the provision is minted in
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// period_provision are the coins minted in the period
+	PeriodProvision types.DecCoin `protobuf:"bytes,2,opt,name=period_provision,json=periodProvision,proto3" json:"period_provision"`
+	// epoch_provision are the coins minted in an epoch of the period
+	EpochProvision types.DecCoin `protobuf:"bytes,3,opt,name=epoch_provision,json=epochProvision,proto3" json:"epoch_provision"`
+}
+
+func (m *PeriodProvision) Reset()         { *m = PeriodProvision{} }
+func (m *PeriodProvision) String() string { return proto.CompactTextString(m) }
+func (*PeriodProvision) ProtoMessage()    {}
+func (*PeriodProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{26}
+}
+func (m *PeriodProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodProvision.Merge(m, src)
+}
+func (m *PeriodProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodProvision proto.InternalMessageInfo
+
+func (m *PeriodProvision) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodProvision) GetPeriodProvision() types.DecCoin {
+	if m != nil {
+		return m.PeriodProvision
+	}
+	return types.DecCoin{}
+}
+
+func (m *PeriodProvision) GetEpochProvision() types.DecCoin {
+	if m != nil {
+		return m.EpochProvision
+	}
+	return types.DecCoin{}
+}
+
+// QueryMaxSupplyRequest is the request type for the Query/MaxSupply RPC
+// method.
+type QueryMaxSupplyRequest struct {
+}
+
+func (m *QueryMaxSupplyRequest) Reset()         { *m = QueryMaxSupplyRequest{} }
+func (m *QueryMaxSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxSupplyRequest) ProtoMessage()    {}
+func (*QueryMaxSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{27}
+}
+func (m *QueryMaxSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaxSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxSupplyRequest.Merge(m, src)
+}
+func (m *QueryMaxSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaxSupplyRequest proto.InternalMessageInfo
+
+// QueryMaxSupplyResponse is the response type for the Query/MaxSupply RPC
+// method.
+type QueryMaxSupplyResponse struct {
+	// max_supply of the mint denom, zero for no limit
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// supply of the mint denom
+	Supply types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply"`
+	// headroom left below the max supply, zero for no limit
+	Headroom cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=headroom,proto3,customtype=cosmossdk.io/math.Int" json:"headroom"`
+	// reached is true once inflation was switched off at the max supply
+	Reached bool `protobuf:"varint,4,opt,name=reached,proto3" json:"reached,omitempty"`
+}
+
+func (m *QueryMaxSupplyResponse) Reset()         { *m = QueryMaxSupplyResponse{} }
+func (m *QueryMaxSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxSupplyResponse) ProtoMessage()    {}
+func (*QueryMaxSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{28}
+}
+func (m *QueryMaxSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaxSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxSupplyResponse.Merge(m, src)
+}
+func (m *QueryMaxSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaxSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryMaxSupplyResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *QueryMaxSupplyResponse) GetReached() bool {
+	if m != nil {
+		return m.Reached
+	}
+	return false
+}
+
+// QueryDynamicInflationRequest is the request type for the
+// Query/DynamicInflation RPC method.
+type QueryDynamicInflationRequest struct {
+}
+
+func (m *QueryDynamicInflationRequest) Reset()         { *m = QueryDynamicInflationRequest{} }
+func (m *QueryDynamicInflationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicInflationRequest) ProtoMessage()    {}
+func (*QueryDynamicInflationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{29}
+}
+func (m *QueryDynamicInflationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicInflationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicInflationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicInflationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicInflationRequest.Merge(m, src)
+}
+func (m *QueryDynamicInflationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicInflationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicInflationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicInflationRequest proto.InternalMessageInfo
+
+// QueryDynamicInflationResponse is the response type for the
+// Query/DynamicInflation RPC method.
+type QueryDynamicInflationResponse struct {
+	// dynamic_inflation params, not set if dynamic inflation is disabled
+	DynamicInflation *DynamicInflation `protobuf:"bytes,1,opt,name=dynamic_inflation,json=dynamicInflation,proto3" json:"dynamic_inflation,omitempty"`
+	// multiplier of the scheduled epoch provision
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+	// bonded_ratio of the staking tokens
+	BondedRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonded_ratio,json=bondedRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonded_ratio"`
+}
+
+func (m *QueryDynamicInflationResponse) Reset()         { *m = QueryDynamicInflationResponse{} }
+func (m *QueryDynamicInflationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDynamicInflationResponse) ProtoMessage()    {}
+func (*QueryDynamicInflationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{30}
+}
+func (m *QueryDynamicInflationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDynamicInflationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDynamicInflationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDynamicInflationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDynamicInflationResponse.Merge(m, src)
+}
+func (m *QueryDynamicInflationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDynamicInflationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDynamicInflationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDynamicInflationResponse proto.InternalMessageInfo
+
+func (m *QueryDynamicInflationResponse) GetDynamicInflation() *DynamicInflation {
+	if m != nil {
+		return m.DynamicInflation
+	}
+	return nil
+}
+
+// QuerySimulateEmissionRequest is the request type for the
+// Query/SimulateEmission RPC method.
+type QuerySimulateEmissionRequest struct {
+	// params to simulate instead of the current params, if set
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// period_mint_provisions to simulate instead of the current ones, if set
+	PeriodMintProvisions github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=period_mint_provisions,json=periodMintProvisions,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"period_mint_provisions"`
+	// epochs_per_period to simulate instead of the current one, if positive
+	EpochsPerPeriod int64 `protobuf:"varint,3,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
+	// epochs to simulate starting at the next epoch, one period if zero, at most
+	// 366
+	Epochs uint32 `protobuf:"varint,4,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QuerySimulateEmissionRequest) Reset()         { *m = QuerySimulateEmissionRequest{} }
+func (m *QuerySimulateEmissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateEmissionRequest) ProtoMessage()    {}
+func (*QuerySimulateEmissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{31}
+}
+func (m *QuerySimulateEmissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateEmissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateEmissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateEmissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateEmissionRequest.Merge(m, src)
+}
+func (m *QuerySimulateEmissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateEmissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateEmissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateEmissionRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateEmissionRequest) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *QuerySimulateEmissionRequest) GetPeriodMintProvisions() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.PeriodMintProvisions
+	}
+	return nil
+}
+
+func (m *QuerySimulateEmissionRequest) GetEpochsPerPeriod() int64 {
+	if m != nil {
+		return m.EpochsPerPeriod
+	}
+	return 0
+}
+
+func (m *QuerySimulateEmissionRequest) GetEpochs() uint32 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+// QuerySimulateEmissionResponse is the response type for the
+// Query/SimulateEmission RPC method.
+type QuerySimulateEmissionResponse struct {
+	// epochs that were simulated
+	Epochs []SimulatedEpoch `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	// periods the simulated epochs fall in
+	Periods []SimulatedPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods"`
+	// allocations of all simulated epochs by recipient
+	Allocations []EmissionAllocation `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations"`
+	// supply of the mint denom after the last simulated epoch
+	Supply types.Coin `protobuf:"bytes,4,opt,name=supply,proto3" json:"supply"`
+}
+
+func (m *QuerySimulateEmissionResponse) Reset()         { *m = QuerySimulateEmissionResponse{} }
+func (m *QuerySimulateEmissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateEmissionResponse) ProtoMessage()    {}
+func (*QuerySimulateEmissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{32}
+}
+func (m *QuerySimulateEmissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateEmissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateEmissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateEmissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateEmissionResponse.Merge(m, src)
+}
+func (m *QuerySimulateEmissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateEmissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateEmissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateEmissionResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateEmissionResponse) GetEpochs() []SimulatedEpoch {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *QuerySimulateEmissionResponse) GetPeriods() []SimulatedPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func (m *QuerySimulateEmissionResponse) GetAllocations() []EmissionAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *QuerySimulateEmissionResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+// SimulatedEpoch is the projected emission of an epoch
+type SimulatedEpoch struct {
+	// epoch_number of the epoch
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// period the epoch falls in
+	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
+	// minted coins of the epoch
+	Minted types.Coin `protobuf:"bytes,3,opt,name=minted,proto3" json:"minted"`
+	// allocations of the minted coins and the carried remainder by recipient
+	Allocations []EmissionAllocation `protobuf:"bytes,4,rep,name=allocations,proto3" json:"allocations"`
+	// supply of the mint denom after the epoch
+	Supply types.Coin `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply"`
+}
+
+func (m *SimulatedEpoch) Reset()         { *m = SimulatedEpoch{} }
+func (m *SimulatedEpoch) String() string { return proto.CompactTextString(m) }
+func (*SimulatedEpoch) ProtoMessage()    {}
+func (*SimulatedEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{33}
+}
+func (m *SimulatedEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulatedEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedEpoch.Merge(m, src)
+}
+func (m *SimulatedEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedEpoch proto.InternalMessageInfo
+
+func (m *SimulatedEpoch) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *SimulatedEpoch) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *SimulatedEpoch) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
+	}
+	return types.Coin{}
+}
+
+func (m *SimulatedEpoch) GetAllocations() []EmissionAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *SimulatedEpoch) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+// SimulatedPeriod is the projected emission of a period
+type SimulatedPeriod struct {
+	// period of the simulated epochs
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// minted coins of the simulated epochs of the period
+	Minted types.Coin `protobuf:"bytes,2,opt,name=minted,proto3" json:"minted"`
+}
+
+func (m *SimulatedPeriod) Reset()         { *m = SimulatedPeriod{} }
+func (m *SimulatedPeriod) String() string { return proto.CompactTextString(m) }
+func (*SimulatedPeriod) ProtoMessage()    {}
+func (*SimulatedPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{34}
+}
+func (m *SimulatedPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulatedPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulatedPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedPeriod.Merge(m, src)
+}
+func (m *SimulatedPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulatedPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulatedPeriod proto.InternalMessageInfo
+
+func (m *SimulatedPeriod) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *SimulatedPeriod) GetMinted() types.Coin {
+	if m != nil {
+		return m.Minted
+	}
+	return types.Coin{}
+}
+
+// EmissionAllocation is the amount a recipient receives
+type EmissionAllocation struct {
+	// recipient as accepted by an inflation share, or "validators"
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount the recipient receives
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EmissionAllocation) Reset()         { *m = EmissionAllocation{} }
+func (m *EmissionAllocation) String() string { return proto.CompactTextString(m) }
+func (*EmissionAllocation) ProtoMessage()    {}
+func (*EmissionAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae5baee6426d48ab, []int{35}
+}
+func (m *EmissionAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionAllocation.Merge(m, src)
+}
+func (m *EmissionAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionAllocation proto.InternalMessageInfo
+
+func (m *EmissionAllocation) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EmissionAllocation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "galactica.inflation.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "galactica.inflation.QueryParamsResponse")
+	proto.RegisterType((*QueryPeriodRequest)(nil), "galactica.inflation.QueryPeriodRequest")
+	proto.RegisterType((*QueryPeriodResponse)(nil), "galactica.inflation.QueryPeriodResponse")
+	proto.RegisterType((*QueryEpochsPerPeriodRequest)(nil), "galactica.inflation.QueryEpochsPerPeriodRequest")
+	proto.RegisterType((*QueryEpochsPerPeriodResponse)(nil), "galactica.inflation.QueryEpochsPerPeriodResponse")
+	proto.RegisterType((*QuerySkippedEpochsRequest)(nil), "galactica.inflation.QuerySkippedEpochsRequest")
+	proto.RegisterType((*QuerySkippedEpochsResponse)(nil), "galactica.inflation.QuerySkippedEpochsResponse")
+	proto.RegisterType((*QueryEpochIdentifierRequest)(nil), "galactica.inflation.QueryEpochIdentifierRequest")
+	proto.RegisterType((*QueryEpochIdentifierResponse)(nil), "galactica.inflation.QueryEpochIdentifierResponse")
+	proto.RegisterType((*QueryPeriodMintProvisionsRequest)(nil), "galactica.inflation.QueryPeriodMintProvisionsRequest")
+	proto.RegisterType((*QueryPeriodMintProvisionsResponse)(nil), "galactica.inflation.QueryPeriodMintProvisionsResponse")
+	proto.RegisterType((*QueryInflationDistributionRequest)(nil), "galactica.inflation.QueryInflationDistributionRequest")
+	proto.RegisterType((*QueryInflationDistributionResponse)(nil), "galactica.inflation.QueryInflationDistributionResponse")
+	proto.RegisterType((*QueryEpochMintProvisionRequest)(nil), "galactica.inflation.QueryEpochMintProvisionRequest")
+	proto.RegisterType((*QueryEpochMintProvisionResponse)(nil), "galactica.inflation.QueryEpochMintProvisionResponse")
+	proto.RegisterType((*QueryInflationRateRequest)(nil), "galactica.inflation.QueryInflationRateRequest")
+	proto.RegisterType((*QueryInflationRateResponse)(nil), "galactica.inflation.QueryInflationRateResponse")
+	proto.RegisterType((*QueryCirculatingSupplyRequest)(nil), "galactica.inflation.QueryCirculatingSupplyRequest")
+	proto.RegisterType((*QueryCirculatingSupplyResponse)(nil), "galactica.inflation.QueryCirculatingSupplyResponse")
+	proto.RegisterType((*QueryFailedEpochsRequest)(nil), "galactica.inflation.QueryFailedEpochsRequest")
+	proto.RegisterType((*QueryFailedEpochsResponse)(nil), "galactica.inflation.QueryFailedEpochsResponse")
+	proto.RegisterType((*QueryRemainderRequest)(nil), "galactica.inflation.QueryRemainderRequest")
+	proto.RegisterType((*QueryRemainderResponse)(nil), "galactica.inflation.QueryRemainderResponse")
+	proto.RegisterType((*QueryEmissionPreviewRequest)(nil), "galactica.inflation.QueryEmissionPreviewRequest")
+	proto.RegisterType((*QueryEmissionPreviewResponse)(nil), "galactica.inflation.QueryEmissionPreviewResponse")
+	proto.RegisterType((*PeriodProvision)(nil), "galactica.inflation.PeriodProvision")
+	proto.RegisterType((*QueryMaxSupplyRequest)(nil), "galactica.inflation.QueryMaxSupplyRequest")
+	proto.RegisterType((*QueryMaxSupplyResponse)(nil), "galactica.inflation.QueryMaxSupplyResponse")
+	proto.RegisterType((*QueryDynamicInflationRequest)(nil), "galactica.inflation.QueryDynamicInflationRequest")
+	proto.RegisterType((*QueryDynamicInflationResponse)(nil), "galactica.inflation.QueryDynamicInflationResponse")
+	proto.RegisterType((*QuerySimulateEmissionRequest)(nil), "galactica.inflation.QuerySimulateEmissionRequest")
+	proto.RegisterType((*QuerySimulateEmissionResponse)(nil), "galactica.inflation.QuerySimulateEmissionResponse")
+	proto.RegisterType((*SimulatedEpoch)(nil), "galactica.inflation.SimulatedEpoch")
+	proto.RegisterType((*SimulatedPeriod)(nil), "galactica.inflation.SimulatedPeriod")
+	proto.RegisterType((*EmissionAllocation)(nil), "galactica.inflation.EmissionAllocation")
+}
+
+func init() { proto.RegisterFile("galactica/inflation/query.proto", fileDescriptor_ae5baee6426d48ab) }
+
+var fileDescriptor_ae5baee6426d48ab = []byte{
+	// 1809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1c, 0x4b,
+	0x15, 0x76, 0xcf, 0xf8, 0x9a, 0xeb, 0xe3, 0x77, 0xc5, 0x36, 0xe3, 0xb6, 0x33, 0xf6, 0x6d, 0x73,
+	0xb9, 0xc6, 0xc6, 0xd3, 0xf1, 0x38, 0xb6, 0x2f, 0x51, 0x88, 0x88, 0x63, 0x12, 0x39, 0x21, 0xc4,
+	0xe9, 0x84, 0x0d, 0x9b, 0x51, 0x4f, 0x4f, 0x79, 0x5c, 0x78, 0xfa, 0x91, 0xee, 0x9e, 0x24, 0xde,
+	0x21, 0x36, 0xac, 0x78, 0x48, 0x2c, 0xc9, 0x86, 0x5d, 0xc4, 0x43, 0x02, 0x14, 0x24, 0x16, 0x6c,
+	0x58, 0x20, 0x05, 0x89, 0x45, 0x04, 0x2c, 0x10, 0x8b, 0x80, 0x12, 0x24, 0xfe, 0x06, 0xea, 0x7a,
+	0xf4, 0x63, 0xa6, 0x66, 0xdc, 0x63, 0xa1, 0xbb, 0x49, 0xa6, 0xeb, 0xbc, 0xbe, 0x73, 0xea, 0xd4,
+	0xa9, 0x3a, 0xc7, 0xb0, 0xdc, 0x34, 0x5b, 0xa6, 0x15, 0x12, 0xcb, 0xd4, 0x89, 0x73, 0xdc, 0x32,
+	0x43, 0xe2, 0x3a, 0xfa, 0x93, 0x36, 0xf6, 0xcf, 0x2a, 0x9e, 0xef, 0x86, 0x2e, 0xba, 0x14, 0x33,
+	0x54, 0x62, 0x06, 0x75, 0xc6, 0xb4, 0x89, 0xe3, 0xea, 0xf4, 0x5f, 0xc6, 0xa7, 0xce, 0x36, 0xdd,
+	0xa6, 0x4b, 0x7f, 0xea, 0xd1, 0x2f, 0xbe, 0xba, 0xd4, 0x74, 0xdd, 0x66, 0x0b, 0xeb, 0xa6, 0x47,
+	0x74, 0xd3, 0x71, 0xdc, 0x90, 0xca, 0x07, 0x9c, 0xba, 0x6e, 0xb9, 0x81, 0xed, 0x06, 0x7a, 0xdd,
+	0x0c, 0x30, 0x33, 0xaa, 0x3f, 0xdd, 0xaa, 0xe3, 0xd0, 0xdc, 0xd2, 0x3d, 0xb3, 0x49, 0x1c, 0xca,
+	0xcc, 0x79, 0xcb, 0x69, 0x5e, 0xc1, 0x65, 0xb9, 0x44, 0xd0, 0x17, 0x18, 0xbd, 0xc6, 0x20, 0xb0,
+	0x0f, 0x4e, 0x5a, 0x91, 0xf9, 0xe8, 0x99, 0xbe, 0x69, 0x0b, 0x8e, 0x55, 0x19, 0x47, 0xfc, 0x8b,
+	0x31, 0x69, 0xb3, 0x80, 0x1e, 0x46, 0x18, 0x8f, 0xa8, 0xa4, 0x81, 0x9f, 0xb4, 0x71, 0x10, 0x6a,
+	0xdf, 0x82, 0x4b, 0x99, 0xd5, 0xc0, 0x73, 0x9d, 0x00, 0xa3, 0x1b, 0x30, 0xc2, 0x2c, 0x94, 0x94,
+	0x15, 0x65, 0x6d, 0xac, 0xba, 0x58, 0x91, 0xc4, 0xb1, 0xc2, 0x84, 0xf6, 0x47, 0x5f, 0xbf, 0x5d,
+	0x1e, 0x7a, 0xf9, 0xdf, 0x5f, 0xaf, 0x2b, 0x06, 0x97, 0x4a, 0x8c, 0x61, 0x9f, 0xb8, 0x0d, 0x61,
+	0x6c, 0x13, 0x2e, 0x65, 0x56, 0xb9, 0xb1, 0x79, 0x18, 0xf1, 0xe8, 0x0a, 0x35, 0x36, 0x6c, 0xf0,
+	0x2f, 0xed, 0x32, 0x2c, 0x52, 0xf6, 0xaf, 0x7b, 0xae, 0x75, 0x12, 0x1c, 0x61, 0x3f, 0xab, 0xed,
+	0x2e, 0x2c, 0xc9, 0xc9, 0x5c, 0xed, 0x3a, 0xcc, 0x60, 0x4a, 0xaa, 0x79, 0xd8, 0xaf, 0xa5, 0x2c,
+	0x14, 0x8d, 0x29, 0x9c, 0x95, 0xd1, 0x16, 0x61, 0x81, 0xea, 0x7a, 0x74, 0x4a, 0x3c, 0x0f, 0x37,
+	0x98, 0x4a, 0x61, 0xe8, 0x16, 0xa8, 0x32, 0x22, 0x37, 0xf3, 0x31, 0x4c, 0x06, 0x8c, 0x50, 0x63,
+	0x5a, 0xb9, 0x17, 0x13, 0x41, 0x9a, 0x3d, 0xeb, 0xcc, 0x61, 0x03, 0x3b, 0x21, 0x39, 0x26, 0xd8,
+	0x17, 0x36, 0x0e, 0x61, 0x49, 0x4e, 0xe6, 0x56, 0xbe, 0x04, 0xd3, 0x54, 0x7b, 0x8d, 0xc4, 0x34,
+	0x6a, 0x67, 0x94, 0xfb, 0x92, 0x88, 0x68, 0x1a, 0xac, 0xa4, 0xa2, 0x7c, 0x9f, 0x38, 0xe1, 0x91,
+	0xef, 0x3e, 0x25, 0x41, 0x94, 0xb9, 0xc2, 0xdc, 0xaf, 0x14, 0xf8, 0xa8, 0x0f, 0x13, 0x37, 0xfa,
+	0x7d, 0x05, 0xe6, 0x59, 0xdc, 0x6a, 0x36, 0x71, 0xc2, 0x9a, 0x17, 0xb3, 0x94, 0x94, 0x95, 0xe2,
+	0xda, 0x58, 0x75, 0xa9, 0xc2, 0x33, 0x35, 0x4a, 0xeb, 0x0a, 0x4f, 0xeb, 0xca, 0x01, 0xb6, 0x6e,
+	0xb9, 0xc4, 0xd9, 0xdf, 0x8e, 0xf2, 0xe2, 0xe7, 0xff, 0x5a, 0xde, 0x68, 0x92, 0xf0, 0xa4, 0x5d,
+	0xaf, 0x58, 0xae, 0xcd, 0x33, 0x9b, 0xff, 0xb7, 0x19, 0x34, 0x4e, 0xf5, 0xf0, 0xcc, 0xc3, 0x81,
+	0x90, 0x09, 0x8c, 0x59, 0x4f, 0x82, 0x48, 0x5b, 0xe5, 0x70, 0x0f, 0x45, 0xee, 0x1d, 0x90, 0x20,
+	0xf4, 0x49, 0xbd, 0x1d, 0xfd, 0x16, 0x4e, 0xfd, 0x40, 0x01, 0xad, 0x1f, 0x17, 0xf7, 0xaa, 0x09,
+	0xf3, 0x71, 0x0a, 0xd7, 0x1a, 0x29, 0x0e, 0x9e, 0xeb, 0xeb, 0xd2, 0x5c, 0x97, 0xea, 0xdc, 0x1f,
+	0x8e, 0x5c, 0x34, 0xe6, 0x88, 0x8c, 0xa8, 0xad, 0x40, 0x39, 0xd9, 0xd3, 0x8c, 0x43, 0x02, 0xf1,
+	0x33, 0x58, 0xee, 0xc9, 0xc1, 0xd1, 0x3e, 0x86, 0x59, 0xb6, 0xf1, 0xd9, 0x1d, 0xe0, 0x58, 0xfb,
+	0x6f, 0x00, 0x43, 0x87, 0x70, 0x97, 0xf6, 0x38, 0xdf, 0x63, 0xaf, 0x0c, 0x33, 0xc4, 0x02, 0xd5,
+	0x09, 0xa8, 0x32, 0x22, 0x07, 0x74, 0x17, 0x26, 0x93, 0xf0, 0xf9, 0x66, 0x88, 0x59, 0x1e, 0xee,
+	0xaf, 0x46, 0xc6, 0xfe, 0xf9, 0x76, 0x79, 0x91, 0x21, 0x0a, 0x1a, 0xa7, 0x15, 0xe2, 0xea, 0xb6,
+	0x19, 0x9e, 0x54, 0xbe, 0x81, 0x9b, 0xa6, 0x75, 0x76, 0x80, 0x2d, 0x63, 0x82, 0xa4, 0x75, 0x6a,
+	0xcb, 0x70, 0x99, 0x5a, 0xba, 0x45, 0x7c, 0xab, 0x1d, 0xad, 0x3b, 0xcd, 0x47, 0x6d, 0xcf, 0x6b,
+	0x9d, 0x09, 0x28, 0x01, 0x94, 0x7b, 0x31, 0x70, 0x38, 0x0f, 0x01, 0x59, 0x09, 0xb1, 0x16, 0x50,
+	0xea, 0x00, 0xd1, 0x99, 0xb1, 0x3a, 0x55, 0x6b, 0x75, 0x28, 0x51, 0xa3, 0xb7, 0x4d, 0xd2, 0xea,
+	0xa8, 0x05, 0xe8, 0x36, 0x40, 0x52, 0xdb, 0xb9, 0x99, 0x2f, 0x66, 0xcc, 0xb0, 0xdb, 0x47, 0x18,
+	0x3b, 0x32, 0x9b, 0x22, 0xae, 0x46, 0x4a, 0x52, 0xfb, 0x8d, 0x02, 0x0b, 0x12, 0x23, 0xdc, 0xa9,
+	0x7b, 0x30, 0x71, 0x4c, 0xd7, 0x93, 0x92, 0x12, 0x1d, 0xb7, 0x15, 0x69, 0x66, 0xa6, 0x34, 0x70,
+	0x9f, 0xc6, 0x8f, 0x53, 0x4a, 0xd1, 0x9d, 0x0c, 0xe4, 0x02, 0x85, 0xfc, 0xc9, 0xb9, 0x90, 0x19,
+	0x92, 0x0c, 0xe6, 0xcf, 0xc3, 0x1c, 0x85, 0x6c, 0x60, 0xdb, 0x24, 0x4e, 0x23, 0x29, 0x5e, 0x7f,
+	0x57, 0x60, 0xbe, 0x93, 0xc2, 0x3d, 0x21, 0x30, 0xea, 0x8b, 0x45, 0xee, 0xc5, 0x82, 0x74, 0x57,
+	0xe8, 0x96, 0x5c, 0xe1, 0x15, 0x63, 0x2d, 0x47, 0xc5, 0x60, 0xe5, 0x22, 0xd1, 0x8e, 0x1e, 0xc0,
+	0x74, 0xfc, 0x51, 0xf3, 0xdc, 0x16, 0xb1, 0xce, 0xa8, 0xb7, 0x93, 0xd5, 0x2f, 0x48, 0xe3, 0x16,
+	0x83, 0x3d, 0xa2, 0xbc, 0xc6, 0x94, 0x9f, 0x5d, 0xd0, 0xf6, 0x44, 0xc9, 0xb6, 0x49, 0x10, 0x9d,
+	0x9a, 0x23, 0x1f, 0x3f, 0x25, 0xf8, 0x99, 0x48, 0x85, 0x12, 0x7c, 0x8e, 0xd5, 0x2a, 0x56, 0xf1,
+	0x27, 0x0c, 0xf1, 0xa9, 0x7d, 0x07, 0x96, 0xe4, 0x82, 0xf1, 0x11, 0x82, 0xae, 0x52, 0x2a, 0xc7,
+	0xc8, 0xca, 0x73, 0x7c, 0x6e, 0xf9, 0xfe, 0xa6, 0xa4, 0xb5, 0x3f, 0x29, 0x30, 0xd5, 0xc1, 0xd5,
+	0xeb, 0x42, 0x45, 0xf7, 0x61, 0x9a, 0x97, 0xf3, 0xa4, 0x8e, 0x14, 0x72, 0x9f, 0x94, 0x29, 0xaf,
+	0xc3, 0xcc, 0x3d, 0x60, 0x77, 0x4f, 0x4a, 0x5b, 0x31, 0xb7, 0xb6, 0x49, 0x2a, 0x9a, 0x54, 0x24,
+	0x91, 0x5c, 0xf7, 0xcd, 0xe7, 0xd9, 0x12, 0xf0, 0xdd, 0x02, 0xcc, 0x77, 0x52, 0x92, 0x38, 0xda,
+	0xe6, 0xf3, 0xf4, 0x99, 0x1f, 0xdd, 0xdf, 0xe0, 0x65, 0x68, 0xae, 0xbb, 0x0c, 0x1d, 0x3a, 0xe1,
+	0x5f, 0x5f, 0x6d, 0x02, 0xc7, 0x76, 0xe8, 0x84, 0xc6, 0xa8, 0x2d, 0x74, 0xa2, 0x3d, 0x18, 0xe1,
+	0x7a, 0x58, 0x44, 0xfa, 0x64, 0x29, 0x73, 0x80, 0xb3, 0xa3, 0x3b, 0xf0, 0xe1, 0x09, 0x36, 0x1b,
+	0xbe, 0xeb, 0xda, 0xa5, 0xe2, 0xe0, 0x10, 0x62, 0xe1, 0x28, 0x9f, 0x7c, 0x6c, 0x5a, 0x27, 0xb8,
+	0x51, 0x1a, 0x5e, 0x51, 0xd6, 0x3e, 0x34, 0xc4, 0xa7, 0x56, 0xe6, 0xf9, 0x74, 0x70, 0xe6, 0x98,
+	0x36, 0xb1, 0x92, 0xba, 0xcc, 0x43, 0xf4, 0xa2, 0x00, 0x97, 0x7b, 0x30, 0xf0, 0x48, 0x19, 0x30,
+	0xd3, 0x60, 0xb4, 0x5a, 0x9c, 0x5c, 0xbc, 0x7a, 0x7d, 0x2c, 0x4d, 0xbc, 0x2e, 0x4d, 0xd3, 0x8d,
+	0x8e, 0x15, 0xf4, 0x10, 0xc0, 0x6e, 0xb7, 0x42, 0xe2, 0xb5, 0xa2, 0xc7, 0x48, 0x81, 0xba, 0xbe,
+	0x95, 0xe3, 0x12, 0x48, 0x05, 0x20, 0xba, 0x12, 0x52, 0x4a, 0xd0, 0x63, 0x18, 0xaf, 0xbb, 0x4e,
+	0x03, 0x37, 0xa2, 0x8b, 0x85, 0xb8, 0xa5, 0xe2, 0x45, 0x95, 0x8e, 0x31, 0x35, 0x46, 0xa4, 0x45,
+	0xfb, 0x59, 0x81, 0xc7, 0xef, 0x11, 0xb1, 0xa3, 0x4a, 0x8f, 0xc5, 0xb9, 0x14, 0x27, 0x79, 0x7b,
+	0x80, 0xd7, 0xae, 0x78, 0xe2, 0xf6, 0x7b, 0x1c, 0x15, 0x3e, 0xd3, 0xc7, 0x91, 0xfc, 0xa1, 0x5b,
+	0x94, 0x3e, 0x74, 0xa3, 0xd2, 0xc0, 0xaf, 0x94, 0x61, 0x5a, 0xb3, 0xf8, 0x97, 0xf6, 0x5b, 0x91,
+	0x42, 0xdd, 0x31, 0xe2, 0x29, 0x74, 0x33, 0x96, 0x64, 0x05, 0x6b, 0x55, 0x1a, 0x24, 0x21, 0x9e,
+	0xb9, 0x8f, 0xb8, 0x20, 0x3a, 0x48, 0x2a, 0x66, 0xa1, 0x4f, 0xd1, 0x8b, 0x75, 0x30, 0xcc, 0x5c,
+	0x89, 0x10, 0x45, 0x0f, 0x60, 0xcc, 0x6c, 0xb5, 0x5c, 0x8b, 0x32, 0x07, 0xa5, 0x22, 0xd5, 0xf4,
+	0x89, 0x54, 0x93, 0x70, 0xe2, 0x66, 0xcc, 0xcf, 0x95, 0xa5, 0x35, 0xa4, 0x8e, 0xfe, 0xf0, 0x40,
+	0x47, 0x5f, 0xfb, 0x61, 0x01, 0x26, 0xb3, 0x0e, 0xa3, 0x8f, 0x60, 0x9c, 0xd5, 0x44, 0xa7, 0x6d,
+	0xd7, 0xf9, 0x1b, 0xbd, 0x68, 0x8c, 0xd1, 0xb5, 0x6f, 0xd2, 0xa5, 0x54, 0x75, 0x2e, 0x64, 0xaa,
+	0xf3, 0x1e, 0x8c, 0x44, 0x89, 0x84, 0x1b, 0xa5, 0x62, 0x4e, 0x18, 0x8c, 0xbd, 0x33, 0x20, 0xc3,
+	0xff, 0xc7, 0x80, 0x7c, 0x30, 0x58, 0x40, 0xea, 0x30, 0xd5, 0xb1, 0x79, 0x3d, 0xef, 0xa2, 0xc4,
+	0xdb, 0xc2, 0x40, 0xde, 0x6a, 0xa7, 0x80, 0xba, 0xbd, 0x40, 0x4b, 0xd1, 0x3b, 0xc3, 0x22, 0x1e,
+	0xc1, 0x4e, 0xc8, 0x1b, 0xa3, 0x64, 0x21, 0x32, 0x66, 0xda, 0x6e, 0xdb, 0x09, 0x73, 0x1b, 0x63,
+	0xec, 0xd5, 0x5f, 0xcc, 0xc3, 0x07, 0xf4, 0x58, 0xa0, 0x1f, 0x29, 0x30, 0xc2, 0x2a, 0x00, 0x92,
+	0x87, 0xb6, 0xbb, 0xb9, 0x56, 0xd7, 0xce, 0x67, 0x64, 0x87, 0x4b, 0xab, 0x7e, 0xef, 0x6f, 0xff,
+	0xf9, 0x49, 0xe1, 0xcb, 0x68, 0x5d, 0xbf, 0x23, 0x24, 0x36, 0x2d, 0xd7, 0xf7, 0xf4, 0xde, 0xbd,
+	0x3f, 0x43, 0xc4, 0x82, 0xd9, 0x0f, 0x51, 0xba, 0x67, 0x56, 0xd7, 0xce, 0x67, 0xbc, 0x10, 0x22,
+	0x06, 0xe3, 0x77, 0x0a, 0x4c, 0x75, 0x74, 0xe3, 0xe8, 0x4a, 0x6f, 0x8b, 0xf2, 0xbe, 0x5e, 0xdd,
+	0x1a, 0x40, 0x82, 0x83, 0xfd, 0x2a, 0x05, 0xbb, 0x87, 0x76, 0xf2, 0x80, 0xed, 0xaa, 0x95, 0xe8,
+	0x97, 0x0a, 0x4c, 0x64, 0x9a, 0x7b, 0x54, 0xe9, 0x8d, 0x41, 0x36, 0x22, 0x50, 0xf5, 0xdc, 0xfc,
+	0x1c, 0xf1, 0x35, 0x8a, 0xf8, 0x2a, 0xaa, 0xe6, 0x41, 0x9c, 0x9d, 0x2f, 0xa0, 0x57, 0x22, 0xcc,
+	0x49, 0xd3, 0x7f, 0x6e, 0x98, 0xbb, 0x26, 0x0e, 0xea, 0xd6, 0x00, 0x12, 0x1c, 0xf4, 0x75, 0x0a,
+	0x7a, 0x17, 0x5d, 0xcd, 0x1d, 0xe6, 0xd4, 0xb8, 0x02, 0xfd, 0x59, 0x81, 0x59, 0xd9, 0xb8, 0x01,
+	0xed, 0x9c, 0x97, 0x94, 0xd2, 0x19, 0x86, 0xba, 0x3b, 0xa8, 0x18, 0xf7, 0x62, 0x9f, 0x7a, 0x71,
+	0x1d, 0x5d, 0xcb, 0x9f, 0xd9, 0x9d, 0x37, 0x3c, 0xfa, 0x8b, 0x02, 0x73, 0xd2, 0x89, 0x00, 0xea,
+	0x83, 0xaa, 0xdf, 0xf0, 0x42, 0xdd, 0x1b, 0x58, 0xee, 0x22, 0xee, 0xc8, 0x07, 0x1f, 0xe8, 0x8f,
+	0x0a, 0xa0, 0xee, 0x19, 0x04, 0xda, 0x3e, 0x27, 0x45, 0x64, 0x33, 0x0d, 0xf5, 0xea, 0x60, 0x42,
+	0xdc, 0x8b, 0xaf, 0x51, 0x2f, 0xae, 0xa1, 0x4f, 0xf3, 0xa7, 0x56, 0x76, 0x4f, 0xe8, 0x21, 0xce,
+	0x4c, 0x2c, 0xfa, 0x1d, 0x62, 0xd9, 0xdc, 0x43, 0xd5, 0x73, 0xf3, 0x5f, 0xe4, 0x10, 0x67, 0x87,
+	0x26, 0xe8, 0x0f, 0x0a, 0xcc, 0x74, 0x4d, 0x35, 0x50, 0xb5, 0x37, 0x84, 0x5e, 0x33, 0x12, 0x75,
+	0x7b, 0x20, 0x19, 0x0e, 0xfd, 0x06, 0x85, 0xfe, 0x29, 0xda, 0xcd, 0x03, 0xbd, 0x7b, 0xc0, 0x82,
+	0x5e, 0x2a, 0x30, 0x9e, 0x1e, 0x5d, 0xa0, 0xcd, 0xde, 0x28, 0x24, 0x73, 0x14, 0xb5, 0x92, 0x97,
+	0x9d, 0xe3, 0xfd, 0x0a, 0xc5, 0xbb, 0x8d, 0xb6, 0xf2, 0xe0, 0xcd, 0xcc, 0x4e, 0xd0, 0x4f, 0x15,
+	0x18, 0x8d, 0x7b, 0x7d, 0xb4, 0xde, 0xdb, 0x70, 0xe7, 0x5c, 0x43, 0xdd, 0xc8, 0xc5, 0xcb, 0x11,
+	0xee, 0x50, 0x84, 0x3a, 0xda, 0xcc, 0x83, 0x30, 0x99, 0x5a, 0xd0, 0x62, 0x9e, 0x9d, 0x13, 0xf4,
+	0x2d, 0xe6, 0xd2, 0x59, 0x84, 0xba, 0x35, 0x80, 0xc4, 0x85, 0x8a, 0x39, 0x57, 0x52, 0xf3, 0x38,
+	0xc4, 0x17, 0x0a, 0x8c, 0xc6, 0x0d, 0x79, 0xbf, 0xa0, 0x76, 0xf6, 0xf3, 0xea, 0x46, 0x2e, 0x5e,
+	0x0e, 0x72, 0x97, 0x82, 0xbc, 0x82, 0x2a, 0x79, 0x40, 0x26, 0xb3, 0x00, 0xf4, 0x7b, 0x05, 0xa6,
+	0x3b, 0x5b, 0x58, 0xd4, 0x27, 0x48, 0x3d, 0x3a, 0x6b, 0xb5, 0x3a, 0x88, 0xc8, 0x45, 0x1e, 0x23,
+	0x5d, 0x5d, 0x39, 0x85, 0xde, 0xd9, 0x84, 0xf5, 0x83, 0xde, 0xa3, 0xa9, 0x55, 0xab, 0x83, 0x88,
+	0x5c, 0x04, 0x7a, 0xc0, 0xb5, 0xd4, 0x44, 0x72, 0xec, 0x3f, 0x78, 0xfd, 0xae, 0xac, 0xbc, 0x79,
+	0x57, 0x56, 0xfe, 0xfd, 0xae, 0xac, 0xfc, 0xf8, 0x7d, 0x79, 0xe8, 0xcd, 0xfb, 0xf2, 0xd0, 0x3f,
+	0xde, 0x97, 0x87, 0xbe, 0xbd, 0x93, 0xea, 0x72, 0x7b, 0xaa, 0x7e, 0x9e, 0x52, 0x4e, 0x1b, 0xdf,
+	0xfa, 0x08, 0xfd, 0xd3, 0xd5, 0xf6, 0xff, 0x06, 0x00, 0x09, 0xfc, 0x7c, 0x61, 0xe7, 0x1b, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Period retrieves the current inflation period
+	Period(ctx context.Context, in *QueryPeriodRequest, opts ...grpc.CallOption) (*QueryPeriodResponse, error)
+	// EpochsPerPeriod retrieves the number of epochs of an inflation period
+	EpochsPerPeriod(ctx context.Context, in *QueryEpochsPerPeriodRequest, opts ...grpc.CallOption) (*QueryEpochsPerPeriodResponse, error)
+	// SkippedEpochs retrieves the number of epochs without inflation
+	SkippedEpochs(ctx context.Context, in *QuerySkippedEpochsRequest, opts ...grpc.CallOption) (*QuerySkippedEpochsResponse, error)
+	// EpochIdentifier retrieves the identifier of the epochs inflation is
+	// minted for
+	EpochIdentifier(ctx context.Context, in *QueryEpochIdentifierRequest, opts ...grpc.CallOption) (*QueryEpochIdentifierResponse, error)
+	// PeriodMintProvisions retrieves the mint provisions of all inflation
+	// periods
+	PeriodMintProvisions(ctx context.Context, in *QueryPeriodMintProvisionsRequest, opts ...grpc.CallOption) (*QueryPeriodMintProvisionsResponse, error)
+	// InflationDistribution retrieves the distribution of the minted coins
+	InflationDistribution(ctx context.Context, in *QueryInflationDistributionRequest, opts ...grpc.CallOption) (*QueryInflationDistributionResponse, error)
+	// EpochMintProvision retrieves the coins minted in an epoch of the current
+	// period
+	EpochMintProvision(ctx context.Context, in *QueryEpochMintProvisionRequest, opts ...grpc.CallOption) (*QueryEpochMintProvisionResponse, error)
+	// InflationRate retrieves the annualized inflation rate of the current
+	// period
+	InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error)
+	// CirculatingSupply retrieves the supply of the mint denom in circulation
+	CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error)
+	// FailedEpochs retrieves the epochs whose inflation was not minted yet
+	FailedEpochs(ctx context.Context, in *QueryFailedEpochsRequest, opts ...grpc.CallOption) (*QueryFailedEpochsResponse, error)
+	// Remainder retrieves the remainder carried to the next epoch
+	Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error)
+	// EmissionPreview retrieves the mint provisions of the next periods
+	EmissionPreview(ctx context.Context, in *QueryEmissionPreviewRequest, opts ...grpc.CallOption) (*QueryEmissionPreviewResponse, error)
+	// MaxSupply retrieves the max supply and the headroom left below it
+	MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error)
+	// DynamicInflation retrieves the multiplier of the epoch provision and the
+	// bonded ratio it responds to
+	DynamicInflation(ctx context.Context, in *QueryDynamicInflationRequest, opts ...grpc.CallOption) (*QueryDynamicInflationResponse, error)
+	// SimulateEmission projects the emission of the next epochs with proposed
+	// params without changing the state
+	SimulateEmission(ctx context.Context, in *QuerySimulateEmissionRequest, opts ...grpc.CallOption) (*QuerySimulateEmissionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Period(ctx context.Context, in *QueryPeriodRequest, opts ...grpc.CallOption) (*QueryPeriodResponse, error) {
+	out := new(QueryPeriodResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/Period", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochsPerPeriod(ctx context.Context, in *QueryEpochsPerPeriodRequest, opts ...grpc.CallOption) (*QueryEpochsPerPeriodResponse, error) {
+	out := new(QueryEpochsPerPeriodResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EpochsPerPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SkippedEpochs(ctx context.Context, in *QuerySkippedEpochsRequest, opts ...grpc.CallOption) (*QuerySkippedEpochsResponse, error) {
+	out := new(QuerySkippedEpochsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/SkippedEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochIdentifier(ctx context.Context, in *QueryEpochIdentifierRequest, opts ...grpc.CallOption) (*QueryEpochIdentifierResponse, error) {
+	out := new(QueryEpochIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EpochIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PeriodMintProvisions(ctx context.Context, in *QueryPeriodMintProvisionsRequest, opts ...grpc.CallOption) (*QueryPeriodMintProvisionsResponse, error) {
+	out := new(QueryPeriodMintProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/PeriodMintProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationDistribution(ctx context.Context, in *QueryInflationDistributionRequest, opts ...grpc.CallOption) (*QueryInflationDistributionResponse, error) {
+	out := new(QueryInflationDistributionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/InflationDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EpochMintProvision(ctx context.Context, in *QueryEpochMintProvisionRequest, opts ...grpc.CallOption) (*QueryEpochMintProvisionResponse, error) {
+	out := new(QueryEpochMintProvisionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EpochMintProvision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InflationRate(ctx context.Context, in *QueryInflationRateRequest, opts ...grpc.CallOption) (*QueryInflationRateResponse, error) {
+	out := new(QueryInflationRateResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/InflationRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CirculatingSupply(ctx context.Context, in *QueryCirculatingSupplyRequest, opts ...grpc.CallOption) (*QueryCirculatingSupplyResponse, error) {
+	out := new(QueryCirculatingSupplyResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/CirculatingSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FailedEpochs(ctx context.Context, in *QueryFailedEpochsRequest, opts ...grpc.CallOption) (*QueryFailedEpochsResponse, error) {
+	out := new(QueryFailedEpochsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/FailedEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Remainder(ctx context.Context, in *QueryRemainderRequest, opts ...grpc.CallOption) (*QueryRemainderResponse, error) {
+	out := new(QueryRemainderResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/Remainder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionPreview(ctx context.Context, in *QueryEmissionPreviewRequest, opts ...grpc.CallOption) (*QueryEmissionPreviewResponse, error) {
+	out := new(QueryEmissionPreviewResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/EmissionPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxSupply(ctx context.Context, in *QueryMaxSupplyRequest, opts ...grpc.CallOption) (*QueryMaxSupplyResponse, error) {
+	out := new(QueryMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/MaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DynamicInflation(ctx context.Context, in *QueryDynamicInflationRequest, opts ...grpc.CallOption) (*QueryDynamicInflationResponse, error) {
+	out := new(QueryDynamicInflationResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/DynamicInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateEmission(ctx context.Context, in *QuerySimulateEmissionRequest, opts ...grpc.CallOption) (*QuerySimulateEmissionResponse, error) {
+	out := new(QuerySimulateEmissionResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Query/SimulateEmission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Period retrieves the current inflation period
+	Period(context.Context, *QueryPeriodRequest) (*QueryPeriodResponse, error)
+	// EpochsPerPeriod retrieves the number of epochs of an inflation period
+	EpochsPerPeriod(context.Context, *QueryEpochsPerPeriodRequest) (*QueryEpochsPerPeriodResponse, error)
+	// SkippedEpochs retrieves the number of epochs without inflation
+	SkippedEpochs(context.Context, *QuerySkippedEpochsRequest) (*QuerySkippedEpochsResponse, error)
+	// EpochIdentifier retrieves the identifier of the epochs inflation is
+	// minted for
+	EpochIdentifier(context.Context, *QueryEpochIdentifierRequest) (*QueryEpochIdentifierResponse, error)
+	// PeriodMintProvisions retrieves the mint provisions of all inflation
+	// periods
+	PeriodMintProvisions(context.Context, *QueryPeriodMintProvisionsRequest) (*QueryPeriodMintProvisionsResponse, error)
+	// InflationDistribution retrieves the distribution of the minted coins
+	InflationDistribution(context.Context, *QueryInflationDistributionRequest) (*QueryInflationDistributionResponse, error)
+	// EpochMintProvision retrieves the coins minted in an epoch of the current
+	// period
+	EpochMintProvision(context.Context, *QueryEpochMintProvisionRequest) (*QueryEpochMintProvisionResponse, error)
+	// InflationRate retrieves the annualized inflation rate of the current
+	// period
+	InflationRate(context.Context, *QueryInflationRateRequest) (*QueryInflationRateResponse, error)
+	// CirculatingSupply retrieves the supply of the mint denom in circulation
+	CirculatingSupply(context.Context, *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error)
+	// FailedEpochs retrieves the epochs whose inflation was not minted yet
+	FailedEpochs(context.Context, *QueryFailedEpochsRequest) (*QueryFailedEpochsResponse, error)
+	// Remainder retrieves the remainder carried to the next epoch
+	Remainder(context.Context, *QueryRemainderRequest) (*QueryRemainderResponse, error)
+	// EmissionPreview retrieves the mint provisions of the next periods
+	EmissionPreview(context.Context, *QueryEmissionPreviewRequest) (*QueryEmissionPreviewResponse, error)
+	// MaxSupply retrieves the max supply and the headroom left below it
+	MaxSupply(context.Context, *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error)
+	// DynamicInflation retrieves the multiplier of the epoch provision and the
+	// bonded ratio it responds to
+	DynamicInflation(context.Context, *QueryDynamicInflationRequest) (*QueryDynamicInflationResponse, error)
+	// SimulateEmission projects the emission of the next epochs with proposed
+	// params without changing the state
+	SimulateEmission(context.Context, *QuerySimulateEmissionRequest) (*QuerySimulateEmissionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Period(ctx context.Context, req *QueryPeriodRequest) (*QueryPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Period not implemented")
+}
+func (*UnimplementedQueryServer) EpochsPerPeriod(ctx context.Context, req *QueryEpochsPerPeriodRequest) (*QueryEpochsPerPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochsPerPeriod not implemented")
+}
+func (*UnimplementedQueryServer) SkippedEpochs(ctx context.Context, req *QuerySkippedEpochsRequest) (*QuerySkippedEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkippedEpochs not implemented")
+}
+func (*UnimplementedQueryServer) EpochIdentifier(ctx context.Context, req *QueryEpochIdentifierRequest) (*QueryEpochIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) PeriodMintProvisions(ctx context.Context, req *QueryPeriodMintProvisionsRequest) (*QueryPeriodMintProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodMintProvisions not implemented")
+}
+func (*UnimplementedQueryServer) InflationDistribution(ctx context.Context, req *QueryInflationDistributionRequest) (*QueryInflationDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationDistribution not implemented")
+}
+func (*UnimplementedQueryServer) EpochMintProvision(ctx context.Context, req *QueryEpochMintProvisionRequest) (*QueryEpochMintProvisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochMintProvision not implemented")
+}
+func (*UnimplementedQueryServer) InflationRate(ctx context.Context, req *QueryInflationRateRequest) (*QueryInflationRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InflationRate not implemented")
+}
+func (*UnimplementedQueryServer) CirculatingSupply(ctx context.Context, req *QueryCirculatingSupplyRequest) (*QueryCirculatingSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupply not implemented")
+}
+func (*UnimplementedQueryServer) FailedEpochs(ctx context.Context, req *QueryFailedEpochsRequest) (*QueryFailedEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailedEpochs not implemented")
+}
+func (*UnimplementedQueryServer) Remainder(ctx context.Context, req *QueryRemainderRequest) (*QueryRemainderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remainder not implemented")
+}
+func (*UnimplementedQueryServer) EmissionPreview(ctx context.Context, req *QueryEmissionPreviewRequest) (*QueryEmissionPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionPreview not implemented")
+}
+func (*UnimplementedQueryServer) MaxSupply(ctx context.Context, req *QueryMaxSupplyRequest) (*QueryMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxSupply not implemented")
+}
+func (*UnimplementedQueryServer) DynamicInflation(ctx context.Context, req *QueryDynamicInflationRequest) (*QueryDynamicInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamicInflation not implemented")
+}
+func (*UnimplementedQueryServer) SimulateEmission(ctx context.Context, req *QuerySimulateEmissionRequest) (*QuerySimulateEmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateEmission not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Period_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Period(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/Period",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Period(ctx, req.(*QueryPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochsPerPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochsPerPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochsPerPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EpochsPerPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochsPerPeriod(ctx, req.(*QueryEpochsPerPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SkippedEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySkippedEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SkippedEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/SkippedEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SkippedEpochs(ctx, req.(*QuerySkippedEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EpochIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochIdentifier(ctx, req.(*QueryEpochIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PeriodMintProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodMintProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PeriodMintProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/PeriodMintProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PeriodMintProvisions(ctx, req.(*QueryPeriodMintProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/InflationDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationDistribution(ctx, req.(*QueryInflationDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EpochMintProvision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochMintProvisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EpochMintProvision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EpochMintProvision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochMintProvision(ctx, req.(*QueryEpochMintProvisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InflationRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InflationRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/InflationRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InflationRate(ctx, req.(*QueryInflationRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CirculatingSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/CirculatingSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupply(ctx, req.(*QueryCirculatingSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FailedEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedEpochsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FailedEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/FailedEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FailedEpochs(ctx, req.(*QueryFailedEpochsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Remainder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Remainder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/Remainder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Remainder(ctx, req.(*QueryRemainderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/EmissionPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionPreview(ctx, req.(*QueryEmissionPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/MaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxSupply(ctx, req.(*QueryMaxSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DynamicInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDynamicInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DynamicInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/DynamicInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DynamicInflation(ctx, req.(*QueryDynamicInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateEmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateEmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateEmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Query/SimulateEmission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateEmission(ctx, req.(*QuerySimulateEmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "galactica.inflation.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Period",
+			Handler:    _Query_Period_Handler,
+		},
+		{
+			MethodName: "EpochsPerPeriod",
+			Handler:    _Query_EpochsPerPeriod_Handler,
+		},
+		{
+			MethodName: "SkippedEpochs",
+			Handler:    _Query_SkippedEpochs_Handler,
+		},
+		{
+			MethodName: "EpochIdentifier",
+			Handler:    _Query_EpochIdentifier_Handler,
+		},
+		{
+			MethodName: "PeriodMintProvisions",
+			Handler:    _Query_PeriodMintProvisions_Handler,
+		},
+		{
+			MethodName: "InflationDistribution",
+			Handler:    _Query_InflationDistribution_Handler,
+		},
+		{
+			MethodName: "EpochMintProvision",
+			Handler:    _Query_EpochMintProvision_Handler,
+		},
+		{
+			MethodName: "InflationRate",
+			Handler:    _Query_InflationRate_Handler,
+		},
+		{
+			MethodName: "CirculatingSupply",
+			Handler:    _Query_CirculatingSupply_Handler,
+		},
+		{
+			MethodName: "FailedEpochs",
+			Handler:    _Query_FailedEpochs_Handler,
+		},
+		{
+			MethodName: "Remainder",
+			Handler:    _Query_Remainder_Handler,
+		},
+		{
+			MethodName: "EmissionPreview",
+			Handler:    _Query_EmissionPreview_Handler,
+		},
+		{
+			MethodName: "MaxSupply",
+			Handler:    _Query_MaxSupply_Handler,
+		},
+		{
+			MethodName: "DynamicInflation",
+			Handler:    _Query_DynamicInflation_Handler,
+		},
+		{
+			MethodName: "SimulateEmission",
+			Handler:    _Query_SimulateEmission_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "galactica/inflation/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochsPerPeriodRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsPerPeriodRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsPerPeriodRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochsPerPeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochsPerPeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochsPerPeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochsPerPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochsPerPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkippedEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkippedEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkippedEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySkippedEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySkippedEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySkippedEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkippedEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SkippedEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodMintProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodMintProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodMintProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodMintProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodMintProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodMintProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeriodMintProvisions) > 0 {
+		for iNdEx := len(m.PeriodMintProvisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodMintProvisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InflationDistribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochMintProvisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochMintProvisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochMintProvisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEpochMintProvisionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochMintProvisionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochMintProvisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochMintProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCirculatingSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCirculatingSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CirculatingSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedEpochsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedEpochsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedEpochsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FailedEpochs) > 0 {
+		for iNdEx := len(m.FailedEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedEpochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainderPolicy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainderPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provisions) > 0 {
+		for iNdEx := len(m.Provisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EpochProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PeriodProvision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reached {
+		i--
+		if m.Reached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Headroom.Size()
+		i -= size
+		if _, err := m.Headroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDynamicInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDynamicInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDynamicInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedRatio.Size()
+		i -= size
+		if _, err := m.BondedRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.DynamicInflation != nil {
+		{
+			size, err := m.DynamicInflation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateEmissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateEmissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateEmissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochsPerPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochsPerPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PeriodMintProvisions) > 0 {
+		for iNdEx := len(m.PeriodMintProvisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodMintProvisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateEmissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateEmissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateEmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Minted.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Period != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	return n
+}
+
+func (m *QueryEpochsPerPeriodRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochsPerPeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochsPerPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.EpochsPerPeriod))
+	}
+	return n
+}
+
+func (m *QuerySkippedEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySkippedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SkippedEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.SkippedEpochs))
+	}
+	return n
+}
+
+func (m *QueryEpochIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPeriodMintProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPeriodMintProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PeriodMintProvisions) > 0 {
+		for _, e := range m.PeriodMintProvisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryInflationDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationDistribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochMintProvisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochMintProvisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochMintProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCirculatingSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CirculatingSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedEpochsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedEpochs) > 0 {
+		for _, e := range m.FailedEpochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRemainderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RemainderPolicy != 0 {
+		n += 1 + sovQuery(uint64(m.RemainderPolicy))
+	}
+	return n
+}
+
+func (m *QueryEmissionPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	return n
+}
+
+func (m *QueryEmissionPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Provisions) > 0 {
+		for _, e := range m.Provisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeriodProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.PeriodProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochProvision.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMaxSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMaxSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Reached {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDynamicInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDynamicInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DynamicInflation != nil {
+		l = m.DynamicInflation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateEmissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PeriodMintProvisions) > 0 {
+		for _, e := range m.PeriodMintProvisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EpochsPerPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.EpochsPerPeriod))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QuerySimulateEmissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SimulatedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SimulatedPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EmissionAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsPerPeriodRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsPerPeriodRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsPerPeriodRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochsPerPeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochsPerPeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochsPerPeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerPeriod", wireType)
+			}
+			m.EpochsPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySkippedEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySkippedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkippedEpochs", wireType)
+			}
+			m.SkippedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SkippedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochIdentifierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochIdentifierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochIdentifierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochIdentifierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodMintProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodMintProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodMintProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodMintProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodMintProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodMintProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMintProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodMintProvisions = append(m.PeriodMintProvisions, types.DecCoin{})
+			if err := m.PeriodMintProvisions[len(m.PeriodMintProvisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEpochMintProvisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEpochMintProvisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMintProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochMintProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CirculatingSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedEpochsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedEpochsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedEpochsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailedEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailedEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailedEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedEpochs = append(m.FailedEpochs, FailedEpoch{})
+			if err := m.FailedEpochs[len(m.FailedEpochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRemainderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRemainderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRemainderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = append(m.Remainder, types.Coin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainderPolicy", wireType)
+			}
+			m.RemainderPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainderPolicy |= RemainderPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionPreviewRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionPreviewRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionPreviewResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionPreviewResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provisions = append(m.Provisions, PeriodProvision{})
+			if err := m.Provisions[len(m.Provisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochProvision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDynamicInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDynamicInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDynamicInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDynamicInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicInflation == nil {
+				m.DynamicInflation = &DynamicInflation{}
+			}
+			if err := m.DynamicInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateEmissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateEmissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateEmissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodMintProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodMintProvisions = append(m.PeriodMintProvisions, types.DecCoin{})
+			if err := m.PeriodMintProvisions[len(m.PeriodMintProvisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochsPerPeriod", wireType)
+			}
+			m.EpochsPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochsPerPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateEmissionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateEmissionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateEmissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, SimulatedEpoch{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, SimulatedPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, EmissionAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulatedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulatedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulatedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, EmissionAllocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulatedPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -344,12 +7235,64 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -371,7 +7314,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *EmissionAllocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -394,15 +7337,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionAllocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -429,7 +7404,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
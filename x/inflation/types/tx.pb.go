@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -130,232 +133,2643 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "galactica.inflation.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "galactica.inflation.MsgUpdateParamsResponse")
+// MsgAddInflationShare is the Msg/AddInflationShare request type.
+type MsgAddInflationShare struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// share to add. The recipient must not receive a share yet.
+	Share InflationShare `protobuf:"bytes,2,opt,name=share,proto3" json:"share"`
 }
 
-func init() { proto.RegisterFile("galactica/inflation/tx.proto", fileDescriptor_a285fa08f5eebb90) }
+func (m *MsgAddInflationShare) Reset()         { *m = MsgAddInflationShare{} }
+func (m *MsgAddInflationShare) String() string { return proto.CompactTextString(m) }
+func (*MsgAddInflationShare) ProtoMessage()    {}
+func (*MsgAddInflationShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{2}
+}
+func (m *MsgAddInflationShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddInflationShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddInflationShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddInflationShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddInflationShare.Merge(m, src)
+}
+func (m *MsgAddInflationShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddInflationShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddInflationShare.DiscardUnknown(m)
+}
 
-var fileDescriptor_a285fa08f5eebb90 = []byte{
-	// 347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4f, 0xcc, 0x49,
-	0x4c, 0x2e, 0xc9, 0x4c, 0x4e, 0xd4, 0xcf, 0xcc, 0x4b, 0xcb, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xcb, 0xea, 0xc1, 0x65, 0xa5,
-	0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x9d, 0x94, 0x78, 0x72, 0x7e, 0x71,
-	0x6e, 0x7e, 0xb1, 0x7e, 0x6e, 0x71, 0xba, 0x7e, 0x99, 0x21, 0x88, 0x82, 0x4a, 0x48, 0x42, 0x24,
-	0xe2, 0xc1, 0x3c, 0x7d, 0x08, 0x07, 0x2a, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x11, 0x07, 0xb1,
-	0xa0, 0xa2, 0x0a, 0xd8, 0xdc, 0x53, 0x90, 0x58, 0x94, 0x98, 0x0b, 0xd5, 0xa7, 0x74, 0x98, 0x91,
-	0x8b, 0xdf, 0xb7, 0x38, 0x3d, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0x35, 0x00, 0x2c, 0x23, 0x64, 0xc6,
-	0xc5, 0x99, 0x58, 0x5a, 0x92, 0x91, 0x5f, 0x94, 0x59, 0x52, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0xe9, 0x24, 0x71, 0x69, 0x8b, 0xae, 0x08, 0xd4, 0x42, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2,
-	0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x84, 0x52, 0x21, 0x3b, 0x2e, 0x36, 0x88, 0xd9, 0x12,
-	0x4c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xd2, 0x7a, 0x58, 0x3c, 0xac, 0x07, 0xb1, 0xc4, 0x89, 0xf3,
-	0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0x75, 0x59, 0x59, 0x34, 0x3d,
-	0xdf, 0xa0, 0x85, 0x30, 0xaf, 0xeb, 0xf9, 0x06, 0x2d, 0x55, 0x84, 0x07, 0x2a, 0x90, 0xbc, 0x80,
-	0xe6, 0x62, 0x25, 0x49, 0x2e, 0x71, 0x34, 0xa1, 0xa0, 0xd4, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54,
-	0xa3, 0x02, 0x2e, 0x66, 0xdf, 0xe2, 0x74, 0xa1, 0x24, 0x2e, 0x1e, 0x14, 0x3f, 0xaa, 0x60, 0x75,
-	0x1b, 0x9a, 0x21, 0x52, 0x3a, 0xc4, 0xa8, 0x82, 0x59, 0x25, 0xc5, 0xda, 0x00, 0xf2, 0x8e, 0x93,
-	0xff, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa6, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xbb, 0xc3, 0x0c, 0xd6, 0x4d, 0xce, 0x2f, 0x2a, 0xd0,
-	0xc7, 0xee, 0xcf, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x54, 0x19, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x21, 0x80, 0x39, 0xcb, 0x5e, 0x02, 0x00, 0x00,
+var xxx_messageInfo_MsgAddInflationShare proto.InternalMessageInfo
+
+func (m *MsgAddInflationShare) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgAddInflationShare) GetShare() InflationShare {
+	if m != nil {
+		return m.Share
+	}
+	return InflationShare{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgAddInflationShareResponse defines the response structure for executing a
+// MsgAddInflationShare message.
+type MsgAddInflationShareResponse struct {
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgAddInflationShareResponse) Reset()         { *m = MsgAddInflationShareResponse{} }
+func (m *MsgAddInflationShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddInflationShareResponse) ProtoMessage()    {}
+func (*MsgAddInflationShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{3}
+}
+func (m *MsgAddInflationShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddInflationShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddInflationShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddInflationShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddInflationShareResponse.Merge(m, src)
+}
+func (m *MsgAddInflationShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddInflationShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddInflationShareResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgAddInflationShareResponse proto.InternalMessageInfo
+
+// MsgUpdateInflationShare is the Msg/UpdateInflationShare request type.
+type MsgUpdateInflationShare struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// share replaces the existing share of the same recipient
+	Share InflationShare `protobuf:"bytes,2,opt,name=share,proto3" json:"share"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateInflationShare) Reset()         { *m = MsgUpdateInflationShare{} }
+func (m *MsgUpdateInflationShare) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInflationShare) ProtoMessage()    {}
+func (*MsgUpdateInflationShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{4}
+}
+func (m *MsgUpdateInflationShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInflationShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInflationShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInflationShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInflationShare.Merge(m, src)
+}
+func (m *MsgUpdateInflationShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInflationShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInflationShare.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateInflationShare proto.InternalMessageInfo
+
+func (m *MsgUpdateInflationShare) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateInflationShare) GetShare() InflationShare {
+	if m != nil {
+		return m.Share
+	}
+	return InflationShare{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgUpdateInflationShareResponse defines the response structure for executing a
+// MsgUpdateInflationShare message.
+type MsgUpdateInflationShareResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUpdateInflationShareResponse) Reset()         { *m = MsgUpdateInflationShareResponse{} }
+func (m *MsgUpdateInflationShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInflationShareResponse) ProtoMessage()    {}
+func (*MsgUpdateInflationShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{5}
+}
+func (m *MsgUpdateInflationShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateInflationShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateInflationShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateInflationShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInflationShareResponse.Merge(m, src)
+}
+func (m *MsgUpdateInflationShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateInflationShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInflationShareResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateInflationShareResponse proto.InternalMessageInfo
+
+// MsgRemoveInflationShare is the Msg/RemoveInflationShare request type.
+type MsgRemoveInflationShare struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recipient of the share to remove: an account address, "module:<name>",
+	// "community_pool" or "burn"
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+func (m *MsgRemoveInflationShare) Reset()         { *m = MsgRemoveInflationShare{} }
+func (m *MsgRemoveInflationShare) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveInflationShare) ProtoMessage()    {}
+func (*MsgRemoveInflationShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{6}
+}
+func (m *MsgRemoveInflationShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveInflationShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveInflationShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/galactica.inflation.Msg/UpdateParams",
+}
+func (m *MsgRemoveInflationShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveInflationShare.Merge(m, src)
+}
+func (m *MsgRemoveInflationShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveInflationShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveInflationShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveInflationShare proto.InternalMessageInfo
+
+func (m *MsgRemoveInflationShare) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgRemoveInflationShare) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "galactica.inflation.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "galactica/inflation/tx.proto",
+// MsgRemoveInflationShareResponse defines the response structure for executing a
+// MsgRemoveInflationShare message.
+type MsgRemoveInflationShareResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveInflationShareResponse) Reset()         { *m = MsgRemoveInflationShareResponse{} }
+func (m *MsgRemoveInflationShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveInflationShareResponse) ProtoMessage()    {}
+func (*MsgRemoveInflationShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{7}
+}
+func (m *MsgRemoveInflationShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveInflationShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveInflationShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveInflationShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveInflationShareResponse.Merge(m, src)
+}
+func (m *MsgRemoveInflationShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveInflationShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveInflationShareResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveInflationShareResponse proto.InternalMessageInfo
+
+// MsgSetValidatorsShare is the Msg/SetValidatorsShare request type.
+type MsgSetValidatorsShare struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// validators_share is the new share of the inflation allocated to validators
+	ValidatorsShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=validators_share,json=validatorsShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validators_share"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetValidatorsShare) Reset()         { *m = MsgSetValidatorsShare{} }
+func (m *MsgSetValidatorsShare) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorsShare) ProtoMessage()    {}
+func (*MsgSetValidatorsShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{8}
+}
+func (m *MsgSetValidatorsShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorsShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorsShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetValidatorsShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorsShare.Merge(m, src)
+}
+func (m *MsgSetValidatorsShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorsShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorsShare.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetValidatorsShare proto.InternalMessageInfo
+
+func (m *MsgSetValidatorsShare) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetValidatorsShareResponse defines the response structure for executing a
+// MsgSetValidatorsShare message.
+type MsgSetValidatorsShareResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetValidatorsShareResponse) Reset()         { *m = MsgSetValidatorsShareResponse{} }
+func (m *MsgSetValidatorsShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorsShareResponse) ProtoMessage()    {}
+func (*MsgSetValidatorsShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{9}
+}
+func (m *MsgSetValidatorsShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorsShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorsShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorsShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorsShareResponse.Merge(m, src)
+}
+func (m *MsgSetValidatorsShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorsShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorsShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorsShareResponse proto.InternalMessageInfo
+
+// MsgRetryFailedEpoch is the Msg/RetryFailedEpoch request type.
+type MsgRetryFailedEpoch struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// epoch_number of the failed epoch to retry
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// recipient of the whole amount of the epoch: an account address,
+	// "module:<name>", "community_pool" or "burn". If empty, the amount is
+	// allocated according to the current inflation distribution.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgRetryFailedEpoch) Reset()         { *m = MsgRetryFailedEpoch{} }
+func (m *MsgRetryFailedEpoch) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryFailedEpoch) ProtoMessage()    {}
+func (*MsgRetryFailedEpoch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{10}
+}
+func (m *MsgRetryFailedEpoch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryFailedEpoch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryFailedEpoch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryFailedEpoch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryFailedEpoch.Merge(m, src)
+}
+func (m *MsgRetryFailedEpoch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryFailedEpoch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryFailedEpoch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryFailedEpoch proto.InternalMessageInfo
+
+func (m *MsgRetryFailedEpoch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRetryFailedEpoch) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MsgRetryFailedEpoch) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgRetryFailedEpochResponse defines the response structure for executing a
+// MsgRetryFailedEpoch message.
+type MsgRetryFailedEpochResponse struct {
+}
+
+func (m *MsgRetryFailedEpochResponse) Reset()         { *m = MsgRetryFailedEpochResponse{} }
+func (m *MsgRetryFailedEpochResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryFailedEpochResponse) ProtoMessage()    {}
+func (*MsgRetryFailedEpochResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{11}
+}
+func (m *MsgRetryFailedEpochResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryFailedEpochResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryFailedEpochResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryFailedEpochResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryFailedEpochResponse.Merge(m, src)
+}
+func (m *MsgRetryFailedEpochResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryFailedEpochResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryFailedEpochResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryFailedEpochResponse proto.InternalMessageInfo
+
+// MsgRedirectStrandedBalance is the Msg/RedirectStrandedBalance request type.
+type MsgRedirectStrandedBalance struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// recipient of the balance: an account address, "module:<name>",
+	// "community_pool" or "burn"
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *MsgRedirectStrandedBalance) Reset()         { *m = MsgRedirectStrandedBalance{} }
+func (m *MsgRedirectStrandedBalance) String() string { return proto.CompactTextString(m) }
+func (*MsgRedirectStrandedBalance) ProtoMessage()    {}
+func (*MsgRedirectStrandedBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{12}
+}
+func (m *MsgRedirectStrandedBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedirectStrandedBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedirectStrandedBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedirectStrandedBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedirectStrandedBalance.Merge(m, src)
+}
+func (m *MsgRedirectStrandedBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedirectStrandedBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedirectStrandedBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedirectStrandedBalance proto.InternalMessageInfo
+
+func (m *MsgRedirectStrandedBalance) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRedirectStrandedBalance) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// MsgRedirectStrandedBalanceResponse defines the response structure for
+// executing a MsgRedirectStrandedBalance message.
+type MsgRedirectStrandedBalanceResponse struct {
+	// amount that was sent to the recipient
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgRedirectStrandedBalanceResponse) Reset()         { *m = MsgRedirectStrandedBalanceResponse{} }
+func (m *MsgRedirectStrandedBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedirectStrandedBalanceResponse) ProtoMessage()    {}
+func (*MsgRedirectStrandedBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a285fa08f5eebb90, []int{13}
+}
+func (m *MsgRedirectStrandedBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedirectStrandedBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedirectStrandedBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedirectStrandedBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedirectStrandedBalanceResponse.Merge(m, src)
+}
+func (m *MsgRedirectStrandedBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedirectStrandedBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedirectStrandedBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedirectStrandedBalanceResponse proto.InternalMessageInfo
+
+func (m *MsgRedirectStrandedBalanceResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "galactica.inflation.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "galactica.inflation.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddInflationShare)(nil), "galactica.inflation.MsgAddInflationShare")
+	proto.RegisterType((*MsgAddInflationShareResponse)(nil), "galactica.inflation.MsgAddInflationShareResponse")
+	proto.RegisterType((*MsgUpdateInflationShare)(nil), "galactica.inflation.MsgUpdateInflationShare")
+	proto.RegisterType((*MsgUpdateInflationShareResponse)(nil), "galactica.inflation.MsgUpdateInflationShareResponse")
+	proto.RegisterType((*MsgRemoveInflationShare)(nil), "galactica.inflation.MsgRemoveInflationShare")
+	proto.RegisterType((*MsgRemoveInflationShareResponse)(nil), "galactica.inflation.MsgRemoveInflationShareResponse")
+	proto.RegisterType((*MsgSetValidatorsShare)(nil), "galactica.inflation.MsgSetValidatorsShare")
+	proto.RegisterType((*MsgSetValidatorsShareResponse)(nil), "galactica.inflation.MsgSetValidatorsShareResponse")
+	proto.RegisterType((*MsgRetryFailedEpoch)(nil), "galactica.inflation.MsgRetryFailedEpoch")
+	proto.RegisterType((*MsgRetryFailedEpochResponse)(nil), "galactica.inflation.MsgRetryFailedEpochResponse")
+	proto.RegisterType((*MsgRedirectStrandedBalance)(nil), "galactica.inflation.MsgRedirectStrandedBalance")
+	proto.RegisterType((*MsgRedirectStrandedBalanceResponse)(nil), "galactica.inflation.MsgRedirectStrandedBalanceResponse")
+}
+
+func init() { proto.RegisterFile("galactica/inflation/tx.proto", fileDescriptor_a285fa08f5eebb90) }
+
+var fileDescriptor_a285fa08f5eebb90 = []byte{
+	// 846 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x4f, 0xdb, 0x48,
+	0x14, 0xc7, 0xe3, 0x8d, 0x88, 0x94, 0x01, 0x09, 0x08, 0x59, 0x11, 0x0c, 0x38, 0x21, 0xb0, 0x52,
+	0x36, 0xda, 0xd8, 0x24, 0xcb, 0xc2, 0x8a, 0xc3, 0x4a, 0x64, 0xd9, 0x5d, 0xad, 0xb4, 0xd9, 0x5d,
+	0x39, 0x6a, 0x0f, 0x55, 0x25, 0x34, 0xb1, 0xa7, 0x8e, 0x45, 0xec, 0x71, 0x3d, 0x93, 0x88, 0xf4,
+	0x54, 0xb5, 0xa7, 0xf6, 0xd4, 0x3f, 0x03, 0xf5, 0xc4, 0x81, 0xff, 0xa0, 0x52, 0x8b, 0x7a, 0x42,
+	0x9c, 0xaa, 0x1e, 0x68, 0x05, 0x52, 0xf9, 0x37, 0x2a, 0xdb, 0x13, 0xe7, 0x97, 0x5d, 0x12, 0xda,
+	0x4a, 0xbd, 0xc4, 0xf6, 0xbc, 0xef, 0xbc, 0xf7, 0x3e, 0x6f, 0xec, 0xf7, 0x02, 0x96, 0x34, 0xd8,
+	0x80, 0x0a, 0xd5, 0x15, 0x28, 0xe9, 0xe6, 0xbd, 0x06, 0xa4, 0x3a, 0x36, 0x25, 0x7a, 0x20, 0x5a,
+	0x36, 0xa6, 0x38, 0x31, 0xe7, 0x5b, 0x45, 0xdf, 0xca, 0xcf, 0x42, 0x43, 0x37, 0xb1, 0xe4, 0xfe,
+	0x7a, 0x3a, 0x5e, 0x50, 0x30, 0x31, 0x30, 0x91, 0x6a, 0x90, 0x20, 0xa9, 0x55, 0xac, 0x21, 0x0a,
+	0x8b, 0x92, 0x82, 0x75, 0x93, 0xd9, 0xe7, 0x99, 0xdd, 0x20, 0x9a, 0xd4, 0x2a, 0x3a, 0x17, 0x66,
+	0x58, 0xf0, 0x0c, 0x7b, 0xee, 0x93, 0xe4, 0x3d, 0x30, 0x53, 0x52, 0xc3, 0x1a, 0xf6, 0xd6, 0x9d,
+	0x3b, 0xb6, 0xba, 0x1a, 0x94, 0xaf, 0x7f, 0xc7, 0x44, 0x99, 0x20, 0x91, 0x05, 0x6d, 0x68, 0x30,
+	0xe7, 0xd9, 0x17, 0x1c, 0x98, 0xae, 0x10, 0xed, 0x96, 0xa5, 0x42, 0x8a, 0xfe, 0x77, 0x2d, 0x89,
+	0x4d, 0x10, 0x87, 0x4d, 0x5a, 0xc7, 0xb6, 0x4e, 0xdb, 0x29, 0x2e, 0xc3, 0xe5, 0xe2, 0xe5, 0xd4,
+	0xd9, 0x71, 0x21, 0xc9, 0xb2, 0xda, 0x51, 0x55, 0x1b, 0x11, 0x52, 0xa5, 0xb6, 0x6e, 0x6a, 0x72,
+	0x57, 0x9a, 0xf8, 0x0d, 0xc4, 0x3c, 0xdf, 0xa9, 0xef, 0x32, 0x5c, 0x6e, 0xb2, 0xb4, 0x28, 0x06,
+	0x54, 0x4d, 0xf4, 0x82, 0x94, 0xe3, 0x27, 0xe7, 0xe9, 0xc8, 0xe1, 0xd5, 0x51, 0x9e, 0x93, 0xd9,
+	0xae, 0xed, 0x5f, 0x1f, 0x5d, 0x1d, 0xe5, 0xbb, 0xfe, 0x9e, 0x5e, 0x1d, 0xe5, 0x7f, 0xe8, 0x02,
+	0x1c, 0xf4, 0x20, 0x0c, 0x64, 0x9c, 0x5d, 0x00, 0xf3, 0x03, 0x4b, 0x32, 0x22, 0x16, 0x36, 0x09,
+	0xca, 0xbe, 0xe6, 0x40, 0xb2, 0x42, 0xb4, 0x1d, 0x55, 0xfd, 0xbb, 0xb3, 0xbd, 0x5a, 0x87, 0x36,
+	0xba, 0x31, 0xe5, 0x2e, 0x98, 0x20, 0x8e, 0x03, 0x06, 0xb9, 0x1a, 0x08, 0xd9, 0x1f, 0xab, 0x17,
+	0xd6, 0xdb, 0xbc, 0xbd, 0x31, 0xcc, 0xba, 0x12, 0xca, 0xba, 0xa3, 0xaa, 0xae, 0x9f, 0xac, 0x00,
+	0x96, 0x82, 0x58, 0x7c, 0xd8, 0x53, 0xae, 0xa7, 0x10, 0xdf, 0x14, 0xef, 0xd6, 0x30, 0xef, 0xda,
+	0x35, 0x67, 0xeb, 0x21, 0xaf, 0x80, 0x74, 0x08, 0x91, 0x4f, 0x7d, 0xe8, 0x51, 0xcb, 0xc8, 0xc0,
+	0xad, 0x2f, 0x45, 0xbd, 0x04, 0xe2, 0x36, 0x52, 0x74, 0x4b, 0x47, 0x26, 0x75, 0xc9, 0xe3, 0x72,
+	0x77, 0x61, 0x3c, 0x1a, 0x2f, 0xaf, 0x5e, 0x9a, 0xa0, 0x4c, 0x7d, 0x9a, 0x0f, 0x1c, 0xf8, 0xbe,
+	0x42, 0xb4, 0x2a, 0xa2, 0xb7, 0x61, 0x43, 0x57, 0x21, 0xc5, 0x36, 0xf9, 0x3c, 0x96, 0xbb, 0x60,
+	0xa6, 0xe5, 0xbb, 0xda, 0xeb, 0x1e, 0x66, 0xbc, 0x5c, 0x74, 0xce, 0xe9, 0xed, 0x79, 0x7a, 0xd1,
+	0x73, 0x41, 0xd4, 0x7d, 0x51, 0xc7, 0x92, 0x01, 0x69, 0x5d, 0xfc, 0x07, 0x69, 0x50, 0x69, 0xef,
+	0x22, 0xe5, 0xec, 0xb8, 0x00, 0x58, 0x84, 0x5d, 0xa4, 0xc8, 0xd3, 0xad, 0xfe, 0xac, 0xc6, 0xab,
+	0x85, 0x47, 0xe5, 0xd5, 0x22, 0x0d, 0x96, 0x03, 0x39, 0xfd, 0x4a, 0xbc, 0xe4, 0xc0, 0x9c, 0x5b,
+	0x2d, 0x6a, 0xb7, 0xff, 0x84, 0x7a, 0x03, 0xa9, 0x7f, 0x58, 0x58, 0xa9, 0xdf, 0xb8, 0x0e, 0x2b,
+	0x60, 0x0a, 0x39, 0x0e, 0xf6, 0xcc, 0xa6, 0x51, 0x43, 0xb6, 0x5b, 0x83, 0xa8, 0x3c, 0xe9, 0xae,
+	0xfd, 0xeb, 0x2e, 0xf5, 0x1f, 0x7b, 0x74, 0xf0, 0xd8, 0x37, 0x87, 0x51, 0x57, 0x3f, 0x71, 0xec,
+	0xd4, 0x6e, 0xbb, 0x09, 0x67, 0x97, 0xc1, 0x62, 0x00, 0x47, 0xef, 0xfb, 0xcb, 0xbb, 0x76, 0x55,
+	0xb7, 0x91, 0x42, 0xab, 0xd4, 0x86, 0xa6, 0x8a, 0xd4, 0x32, 0x6c, 0x40, 0x53, 0xf9, 0x5a, 0xaf,
+	0xf0, 0x58, 0x0d, 0xa8, 0x93, 0x5a, 0xf6, 0x09, 0x07, 0xb2, 0xe1, 0xa9, 0x76, 0x88, 0x12, 0x0a,
+	0x88, 0x41, 0x03, 0x37, 0x4d, 0x9a, 0xe2, 0x32, 0xd1, 0xdc, 0x64, 0x69, 0x41, 0x64, 0xc9, 0x3a,
+	0x73, 0x51, 0x64, 0x73, 0x51, 0xfc, 0x1d, 0xeb, 0x66, 0x79, 0xdd, 0x79, 0x05, 0x9f, 0xbf, 0x4b,
+	0xe7, 0x34, 0x9d, 0xd6, 0x9b, 0x35, 0x51, 0xc1, 0x06, 0x1b, 0x7f, 0xec, 0x52, 0x20, 0xea, 0xbe,
+	0x44, 0xdb, 0x16, 0x22, 0xee, 0x06, 0x22, 0x33, 0xd7, 0xa5, 0x57, 0x31, 0x10, 0xad, 0x10, 0x2d,
+	0x51, 0x03, 0x53, 0x7d, 0xe3, 0x6b, 0x2d, 0xb0, 0x43, 0x0d, 0xcc, 0x07, 0xfe, 0xa7, 0x51, 0x54,
+	0x3e, 0xd0, 0x7d, 0x30, 0x3b, 0x3c, 0x41, 0x7e, 0x0c, 0x73, 0x31, 0x24, 0xe5, 0x8b, 0x23, 0x4b,
+	0xfd, 0x90, 0x0f, 0x40, 0x32, 0xb0, 0x8f, 0x5f, 0x93, 0xf8, 0x40, 0xe0, 0x8d, 0x71, 0xd4, 0xbd,
+	0xb1, 0x03, 0xbb, 0x69, 0x68, 0xec, 0x20, 0x35, 0xbf, 0x31, 0x8e, 0xda, 0x8f, 0x4d, 0x41, 0x22,
+	0xa0, 0xf7, 0xe5, 0xc3, 0x7c, 0x0d, 0x6b, 0xf9, 0xd2, 0xe8, 0x5a, 0x3f, 0xaa, 0x09, 0x66, 0x86,
+	0xfa, 0x4c, 0x2e, 0x3c, 0xff, 0x7e, 0x25, 0xbf, 0x3e, 0xaa, 0xd2, 0x8f, 0xf7, 0x98, 0x03, 0xf3,
+	0x61, 0x1f, 0xbc, 0x14, 0xee, 0x2d, 0x70, 0x03, 0xbf, 0x35, 0xe6, 0x86, 0x4e, 0x16, 0xfc, 0xc4,
+	0x43, 0x67, 0x46, 0x97, 0xff, 0x3b, 0xb9, 0x10, 0xb8, 0xd3, 0x0b, 0x81, 0x7b, 0x7f, 0x21, 0x70,
+	0xcf, 0x2e, 0x85, 0xc8, 0xe9, 0xa5, 0x10, 0x79, 0x73, 0x29, 0x44, 0xee, 0xfc, 0xd2, 0xf3, 0x55,
+	0xfe, 0xd5, 0x89, 0x51, 0x50, 0xb0, 0x6d, 0x49, 0xc1, 0xcd, 0xc2, 0xfd, 0x50, 0x6b, 0x31, 0xf7,
+	0xcf, 0xe5, 0xcf, 0x1f, 0x07, 0x00, 0xca, 0x72, 0x19, 0x3e, 0x55, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddInflationShare defines a (governance) operation for adding a recipient
+	// of the inflation.
+	AddInflationShare(ctx context.Context, in *MsgAddInflationShare, opts ...grpc.CallOption) (*MsgAddInflationShareResponse, error)
+	// UpdateInflationShare defines a (governance) operation for changing the
+	// name and share of an inflation recipient.
+	UpdateInflationShare(ctx context.Context, in *MsgUpdateInflationShare, opts ...grpc.CallOption) (*MsgUpdateInflationShareResponse, error)
+	// RemoveInflationShare defines a (governance) operation for removing a
+	// recipient of the inflation.
+	RemoveInflationShare(ctx context.Context, in *MsgRemoveInflationShare, opts ...grpc.CallOption) (*MsgRemoveInflationShareResponse, error)
+	// SetValidatorsShare defines a (governance) operation for changing the share
+	// of the inflation allocated to validators.
+	SetValidatorsShare(ctx context.Context, in *MsgSetValidatorsShare, opts ...grpc.CallOption) (*MsgSetValidatorsShareResponse, error)
+	// RetryFailedEpoch defines a (governance) operation for minting and
+	// allocating the inflation of a failed epoch.
+	RetryFailedEpoch(ctx context.Context, in *MsgRetryFailedEpoch, opts ...grpc.CallOption) (*MsgRetryFailedEpochResponse, error)
+	// RedirectStrandedBalance defines a (governance) operation for sending the
+	// coins left in the inflation module account to a recipient.
+	RedirectStrandedBalance(ctx context.Context, in *MsgRedirectStrandedBalance, opts ...grpc.CallOption) (*MsgRedirectStrandedBalanceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddInflationShare(ctx context.Context, in *MsgAddInflationShare, opts ...grpc.CallOption) (*MsgAddInflationShareResponse, error) {
+	out := new(MsgAddInflationShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/AddInflationShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateInflationShare(ctx context.Context, in *MsgUpdateInflationShare, opts ...grpc.CallOption) (*MsgUpdateInflationShareResponse, error) {
+	out := new(MsgUpdateInflationShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/UpdateInflationShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveInflationShare(ctx context.Context, in *MsgRemoveInflationShare, opts ...grpc.CallOption) (*MsgRemoveInflationShareResponse, error) {
+	out := new(MsgRemoveInflationShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/RemoveInflationShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorsShare(ctx context.Context, in *MsgSetValidatorsShare, opts ...grpc.CallOption) (*MsgSetValidatorsShareResponse, error) {
+	out := new(MsgSetValidatorsShareResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/SetValidatorsShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryFailedEpoch(ctx context.Context, in *MsgRetryFailedEpoch, opts ...grpc.CallOption) (*MsgRetryFailedEpochResponse, error) {
+	out := new(MsgRetryFailedEpochResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/RetryFailedEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedirectStrandedBalance(ctx context.Context, in *MsgRedirectStrandedBalance, opts ...grpc.CallOption) (*MsgRedirectStrandedBalanceResponse, error) {
+	out := new(MsgRedirectStrandedBalanceResponse)
+	err := c.cc.Invoke(ctx, "/galactica.inflation.Msg/RedirectStrandedBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddInflationShare defines a (governance) operation for adding a recipient
+	// of the inflation.
+	AddInflationShare(context.Context, *MsgAddInflationShare) (*MsgAddInflationShareResponse, error)
+	// UpdateInflationShare defines a (governance) operation for changing the
+	// name and share of an inflation recipient.
+	UpdateInflationShare(context.Context, *MsgUpdateInflationShare) (*MsgUpdateInflationShareResponse, error)
+	// RemoveInflationShare defines a (governance) operation for removing a
+	// recipient of the inflation.
+	RemoveInflationShare(context.Context, *MsgRemoveInflationShare) (*MsgRemoveInflationShareResponse, error)
+	// SetValidatorsShare defines a (governance) operation for changing the share
+	// of the inflation allocated to validators.
+	SetValidatorsShare(context.Context, *MsgSetValidatorsShare) (*MsgSetValidatorsShareResponse, error)
+	// RetryFailedEpoch defines a (governance) operation for minting and
+	// allocating the inflation of a failed epoch.
+	RetryFailedEpoch(context.Context, *MsgRetryFailedEpoch) (*MsgRetryFailedEpochResponse, error)
+	// RedirectStrandedBalance defines a (governance) operation for sending the
+	// coins left in the inflation module account to a recipient.
+	RedirectStrandedBalance(context.Context, *MsgRedirectStrandedBalance) (*MsgRedirectStrandedBalanceResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) AddInflationShare(ctx context.Context, req *MsgAddInflationShare) (*MsgAddInflationShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInflationShare not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInflationShare(ctx context.Context, req *MsgUpdateInflationShare) (*MsgUpdateInflationShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInflationShare not implemented")
+}
+func (*UnimplementedMsgServer) RemoveInflationShare(ctx context.Context, req *MsgRemoveInflationShare) (*MsgRemoveInflationShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInflationShare not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorsShare(ctx context.Context, req *MsgSetValidatorsShare) (*MsgSetValidatorsShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorsShare not implemented")
+}
+func (*UnimplementedMsgServer) RetryFailedEpoch(ctx context.Context, req *MsgRetryFailedEpoch) (*MsgRetryFailedEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryFailedEpoch not implemented")
+}
+func (*UnimplementedMsgServer) RedirectStrandedBalance(ctx context.Context, req *MsgRedirectStrandedBalance) (*MsgRedirectStrandedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedirectStrandedBalance not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddInflationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddInflationShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddInflationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/AddInflationShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddInflationShare(ctx, req.(*MsgAddInflationShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateInflationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInflationShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInflationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/UpdateInflationShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInflationShare(ctx, req.(*MsgUpdateInflationShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveInflationShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveInflationShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveInflationShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/RemoveInflationShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveInflationShare(ctx, req.(*MsgRemoveInflationShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorsShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorsShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorsShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/SetValidatorsShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorsShare(ctx, req.(*MsgSetValidatorsShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryFailedEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryFailedEpoch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryFailedEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/RetryFailedEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryFailedEpoch(ctx, req.(*MsgRetryFailedEpoch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedirectStrandedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedirectStrandedBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedirectStrandedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/galactica.inflation.Msg/RedirectStrandedBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedirectStrandedBalance(ctx, req.(*MsgRedirectStrandedBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "galactica.inflation.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "AddInflationShare",
+			Handler:    _Msg_AddInflationShare_Handler,
+		},
+		{
+			MethodName: "UpdateInflationShare",
+			Handler:    _Msg_UpdateInflationShare_Handler,
+		},
+		{
+			MethodName: "RemoveInflationShare",
+			Handler:    _Msg_RemoveInflationShare_Handler,
+		},
+		{
+			MethodName: "SetValidatorsShare",
+			Handler:    _Msg_SetValidatorsShare_Handler,
+		},
+		{
+			MethodName: "RetryFailedEpoch",
+			Handler:    _Msg_RetryFailedEpoch_Handler,
+		},
+		{
+			MethodName: "RedirectStrandedBalance",
+			Handler:    _Msg_RedirectStrandedBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "galactica/inflation/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddInflationShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddInflationShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddInflationShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Share.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddInflationShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddInflationShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddInflationShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInflationShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInflationShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInflationShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Share.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInflationShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInflationShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInflationShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveInflationShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveInflationShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveInflationShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveInflationShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveInflationShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveInflationShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorsShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorsShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorsShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorsShare.Size()
+		i -= size
+		if _, err := m.ValidatorsShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorsShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorsShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorsShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryFailedEpoch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryFailedEpoch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryFailedEpoch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryFailedEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryFailedEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryFailedEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedirectStrandedBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedirectStrandedBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedirectStrandedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedirectStrandedBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedirectStrandedBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedirectStrandedBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddInflationShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddInflationShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateInflationShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateInflationShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveInflationShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveInflationShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetValidatorsShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ValidatorsShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetValidatorsShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetryFailedEpoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryFailedEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedirectStrandedBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedirectStrandedBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddInflationShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddInflationShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddInflationShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddInflationShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddInflationShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddInflationShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInflationShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInflationShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInflationShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInflationShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInflationShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInflationShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveInflationShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveInflationShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveInflationShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveInflationShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveInflationShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveInflationShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorsShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorsShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorsShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorsShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorsShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorsShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorsShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRetryFailedEpoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryFailedEpoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryFailedEpoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRetryFailedEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryFailedEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryFailedEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRedirectStrandedBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -378,10 +2792,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedirectStrandedBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedirectStrandedBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -418,9 +2832,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -430,24 +2844,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -470,7 +2883,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedirectStrandedBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -493,12 +2906,46 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedirectStrandedBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedirectStrandedBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
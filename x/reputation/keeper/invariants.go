@@ -0,0 +1,59 @@
+// Galactica is a Layer 1 protocol with zero-knowledge and privacy features.
+// Copyright (C) 2024 Galactica Network
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Galactica-corp/galactica/x/reputation/types"
+)
+
+// RegisterInvariants registers the reputation module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "params", ParamsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the reputation module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return ParamsInvariant(k)
+}
+
+// ParamsInvariant checks that the stored params can be decoded and are valid
+func ParamsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		var params types.Params
+		if bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey); bz != nil {
+			if err := k.cdc.Unmarshal(bz, &params); err != nil {
+				broken = true
+				msg = fmt.Sprintf("\tfailed to decode params: %s\n", err)
+			}
+		}
+		if err := params.Validate(); !broken && err != nil {
+			broken = true
+			msg = fmt.Sprintf("\tinvalid params: %s\n", err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "params", msg), broken
+	}
+}